@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"os"
@@ -33,17 +34,11 @@ func main() {
 		return
 	}
 
-	res, err := db.Exec("insert into lastinsertid_example(id, data) values(:1, :2)", "001", "こんにちわ世界")
+	rowID, _, err := oci8.ExecRowID(context.Background(), db, "insert into lastinsertid_example(id, data) values(:1, :2)", "001", "こんにちわ世界")
 	if err != nil {
 		fmt.Println(err)
 		return
 	}
-	lastInsertId, err := res.LastInsertId()
-	if err != nil {
-		fmt.Println(err)
-		return
-	}
-	rowID := oci8.GetLastInsertId(lastInsertId)
 	var id string
 	err = db.QueryRow("select id from lastinsertid_example where rowid = :1", rowID).Scan(&id)
 	if err != nil {
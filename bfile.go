@@ -0,0 +1,175 @@
+package oci8
+
+// #include "oci8.go.h"
+import "C"
+
+import (
+	"errors"
+	"fmt"
+	"unsafe"
+)
+
+// NewBFile returns a BFile that is bound like BFILENAME(directory, filename).
+// The directory is the name of a directory object, which is case sensitive.
+func NewBFile(directory string, filename string) *BFile {
+	return &BFile{directory: directory, filename: filename}
+}
+
+// newBFile returns a BFile with a copy of the locator, so the BFile stays valid when the locator is reused or freed
+func (conn *Conn) newBFile(locator *C.OCILobLocator) (*BFile, error) {
+	filePP, _, err := conn.ociDescriptorAlloc(C.OCI_DTYPE_FILE, 0)
+	if err != nil {
+		return nil, err
+	}
+	result := C.OCILobLocatorAssign(
+		conn.svc,       // service context handle
+		conn.errHandle, // error handle
+		locator,        // source locator
+		(**C.OCILobLocator)(unsafe.Pointer(filePP)), // destination locator
+	)
+	if result != C.OCI_SUCCESS {
+		C.OCIDescriptorFree(*filePP, C.OCI_DTYPE_FILE)
+		return nil, conn.getError(result)
+	}
+	bfile := &BFile{lob: Lob{conn: conn, locator: (*C.OCILobLocator)(*filePP), form: C.SQLCS_IMPLICIT}}
+
+	directory := make([]byte, bfileDirectorySize)
+	directoryLength := C.ub2(len(directory))
+	filename := make([]byte, bfileFilenameSize)
+	filenameLength := C.ub2(len(filename))
+	result = C.OCILobFileGetName(
+		conn.env,          // environment handle
+		conn.errHandle,    // error handle
+		bfile.lob.locator, // BFILE locator
+		(*C.OraText)(unsafe.Pointer(&directory[0])), // buffer for the directory alias
+		&directoryLength, // IN - size of the directory buffer. OUT - length of the directory alias
+		(*C.OraText)(unsafe.Pointer(&filename[0])), // buffer for the filename
+		&filenameLength, // IN - size of the filename buffer. OUT - length of the filename
+	)
+	if result != C.OCI_SUCCESS {
+		bfile.Close()
+		return nil, conn.getError(result)
+	}
+	bfile.directory = string(directory[:directoryLength])
+	bfile.filename = string(filename[:filenameLength])
+
+	return bfile, nil
+}
+
+// Directory returns the name of the directory object of the BFile
+func (bfile *BFile) Directory() string {
+	return bfile.directory
+}
+
+// Filename returns the name of the file of the BFile in the directory
+func (bfile *BFile) Filename() string {
+	return bfile.filename
+}
+
+// Exists returns true if the file exists on the database server
+func (bfile *BFile) Exists() (bool, error) {
+	err := bfile.lob.valid()
+	if err != nil {
+		return false, err
+	}
+	var exists C.boolean
+	result := C.OCILobFileExists(
+		bfile.lob.conn.svc,       // service context handle
+		bfile.lob.conn.errHandle, // error handle
+		bfile.lob.locator,        // BFILE locator
+		&exists,                  // TRUE if the file exists
+	)
+	if result != C.OCI_SUCCESS {
+		return false, bfile.lob.conn.getError(result)
+	}
+	return exists == C.TRUE, nil
+}
+
+// Open opens the file for reading until Close. Reads open the file if it is not open.
+func (bfile *BFile) Open() error {
+	err := bfile.lob.valid()
+	if err != nil {
+		return err
+	}
+	if bfile.lob.opened {
+		return nil
+	}
+	result := C.OCILobFileOpen(
+		bfile.lob.conn.svc,       // service context handle
+		bfile.lob.conn.errHandle, // error handle
+		bfile.lob.locator,        // BFILE locator
+		C.OCI_FILE_READONLY,      // open mode, BFILEs are read only
+	)
+	err = bfile.lob.conn.getError(result)
+	if err != nil {
+		return err
+	}
+	bfile.lob.opened = true
+	return nil
+}
+
+// Read reads from the file at the current offset
+func (bfile *BFile) Read(p []byte) (int, error) {
+	err := bfile.Open()
+	if err != nil {
+		return 0, err
+	}
+	return bfile.lob.Read(p)
+}
+
+// ReadAt reads len(p) bytes from the file at the offset
+func (bfile *BFile) ReadAt(p []byte, offset int64) (int, error) {
+	err := bfile.Open()
+	if err != nil {
+		return 0, err
+	}
+	return bfile.lob.ReadAt(p, offset)
+}
+
+// Seek sets the offset of the next Read
+func (bfile *BFile) Seek(offset int64, whence int) (int64, error) {
+	return bfile.lob.Seek(offset, whence)
+}
+
+// Size returns the length of the file in bytes
+func (bfile *BFile) Size() (int64, error) {
+	return bfile.lob.Size()
+}
+
+// Close closes the file if it is open and frees the locator
+func (bfile *BFile) Close() error {
+	if bfile.lob.locator == nil {
+		return nil
+	}
+
+	var err error
+	if bfile.lob.opened {
+		result := C.OCILobFileClose(
+			bfile.lob.conn.svc,       // service context handle
+			bfile.lob.conn.errHandle, // error handle
+			bfile.lob.locator,        // BFILE locator
+		)
+		err = bfile.lob.conn.getError(result)
+		bfile.lob.opened = false
+	}
+
+	C.OCIDescriptorFree(unsafe.Pointer(bfile.lob.locator), C.OCI_DTYPE_FILE)
+	bfile.lob.locator = nil
+	return err
+}
+
+// Scan implements sql.Scanner so a BFILE column can be scanned into a BFile. The BFile must be closed.
+func (bfile *BFile) Scan(src interface{}) error {
+	switch value := src.(type) {
+	case *BFile:
+		// the BFile takes the locator, so it stays valid after the rows are closed
+		*bfile = *value
+		value.lob.locator = nil
+		value.lob.opened = false
+	case nil:
+		return errors.New("cannot scan NULL into BFile")
+	default:
+		return fmt.Errorf("cannot scan %T into BFile", src)
+	}
+	return nil
+}
@@ -78,7 +78,7 @@ func freeDefines(defines []defineStruct) {
 		}
 		defines[i].subDefines = nil
 		if defines[i].pbuf != nil {
-			freeBuffer(defines[i].pbuf, defines[i].dataType)
+			freeBufferArray(defines[i].pbuf, defines[i].dataType, defines[i].arraySize)
 			defines[i].pbuf = nil
 		}
 		if defines[i].length != nil {
@@ -89,15 +89,28 @@ func freeDefines(defines []defineStruct) {
 			C.free(unsafe.Pointer(defines[i].indicator))
 			defines[i].indicator = nil
 		}
+		if defines[i].objectType != nil {
+			freeObject(defines[i].objectType, defines[i].objectInstance, defines[i].objectIndicator)
+			defines[i].objectInstance = nil
+			defines[i].objectIndicator = nil
+		}
 		defines[i].defineHandle = nil // should be freed by oci statement close
 	}
 }
 
-// freeBinds frees binds
+// freeBinds frees binds, and the temporary LOBs created for them
 func freeBinds(binds []bindStruct) {
 	for _, bind := range binds {
-		if bind.pbuf != nil {
-			freeBuffer(bind.pbuf, bind.dataType)
+		if bind.lobConn != nil && bind.pbuf != nil && bind.lob == nil && *(*unsafe.Pointer)(bind.pbuf) != nil {
+			// a temporary LOB moved to an out bind Lob has a nil locator and is freed by Lob Close
+			C.OCILobFreeTemporary(bind.lobConn.svc, bind.lobConn.errHandle, *(**C.OCILobLocator)(bind.pbuf))
+		}
+		if bind.pbuf != nil && bind.lob == nil {
+			if bind.isArray {
+				freeBufferArray(bind.pbuf, bind.dataType, bind.arrayLen)
+			} else {
+				freeBuffer(bind.pbuf, bind.dataType)
+			}
 			bind.pbuf = nil
 		}
 		if bind.length != nil {
@@ -108,6 +121,19 @@ func freeBinds(binds []bindStruct) {
 			C.free(unsafe.Pointer(bind.indicator))
 			bind.indicator = nil
 		}
+		if bind.curArrayLen != nil {
+			C.free(unsafe.Pointer(bind.curArrayLen))
+			bind.curArrayLen = nil
+		}
+		if bind.returning != nil {
+			freeReturning(bind.returning)
+			bind.returning = nil
+		}
+		if bind.objectType != nil {
+			freeObject(bind.objectType, bind.objectInstance, bind.objectIndicator)
+			bind.objectInstance = nil
+			bind.objectIndicator = nil
+		}
 		bind.bindHandle = nil // freed by oci statement close
 	}
 }
@@ -117,7 +143,13 @@ func freeBinds(binds []bindStruct) {
 func freeBuffer(buffer unsafe.Pointer, dataType C.ub2) {
 	switch dataType {
 	case C.SQLT_CLOB, C.SQLT_BLOB:
-		C.OCIDescriptorFree(*(*unsafe.Pointer)(buffer), C.OCI_DTYPE_LOB)
+		if *(*unsafe.Pointer)(buffer) != nil {
+			C.OCIDescriptorFree(*(*unsafe.Pointer)(buffer), C.OCI_DTYPE_LOB)
+		}
+	case C.SQLT_FILE:
+		if *(*unsafe.Pointer)(buffer) != nil {
+			C.OCIDescriptorFree(*(*unsafe.Pointer)(buffer), C.OCI_DTYPE_FILE)
+		}
 	case C.SQLT_TIMESTAMP:
 		C.OCIDescriptorFree(*(*unsafe.Pointer)(buffer), C.OCI_DTYPE_TIMESTAMP)
 	case C.SQLT_TIMESTAMP_TZ:
@@ -129,8 +161,26 @@ func freeBuffer(buffer unsafe.Pointer, dataType C.ub2) {
 	case C.SQLT_INTERVAL_YM:
 		C.OCIDescriptorFree(*(*unsafe.Pointer)(buffer), C.OCI_DTYPE_INTERVAL_YM)
 	case C.SQLT_RSET:
-		C.OCIDescriptorFree(*(*unsafe.Pointer)(buffer), C.OCI_HTYPE_STMT)
+		if *(*unsafe.Pointer)(buffer) != nil {
+			C.OCIHandleFree(*(*unsafe.Pointer)(buffer), C.OCI_HTYPE_STMT)
+		}
 	default:
 		C.free(buffer)
 	}
 }
+
+// freeBufferArray frees a C array of buffers.
+// For descriptor data types each non nil descriptor is freed before the array itself.
+func freeBufferArray(buffer unsafe.Pointer, dataType C.ub2, size int) {
+	switch dataType {
+	case C.SQLT_CLOB, C.SQLT_BLOB, C.SQLT_FILE, C.SQLT_TIMESTAMP, C.SQLT_TIMESTAMP_TZ, C.SQLT_TIMESTAMP_LTZ,
+		C.SQLT_INTERVAL_DS, C.SQLT_INTERVAL_YM, C.SQLT_RSET:
+		pointers := (*[1 << 27]unsafe.Pointer)(buffer)[:size:size]
+		for i := range pointers {
+			if pointers[i] != nil {
+				freeBuffer(unsafe.Pointer(&pointers[i]), dataType)
+			}
+		}
+	}
+	C.free(buffer)
+}
@@ -9,6 +9,7 @@ import (
 	"database/sql/driver"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 	"unsafe"
 )
@@ -45,6 +46,8 @@ func (conn *Conn) Close() error {
 	}
 	conn.closed = true
 
+	conn.freeObjectTypes()
+
 	var err error
 	if useOCISessionBegin {
 		if rv := C.OCISessionEnd(
@@ -94,8 +97,8 @@ func (conn *Conn) Prepare(query string) (driver.Stmt, error) {
 
 // PrepareContext prepares a query with context
 func (conn *Conn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
-	if conn.enableQMPlaceholders {
-		query = placeholders(query)
+	if conn.enableQMPlaceholders || conn.enableDollarPlaceholders || conn.enableAtPlaceholders {
+		query = placeholders(query, conn.enableQMPlaceholders, conn.enableDollarPlaceholders, conn.enableAtPlaceholders)
 	}
 
 	queryP := cString(query)
@@ -126,7 +129,9 @@ func (conn *Conn) PrepareContext(ctx context.Context, query string) (driver.Stmt
 			return nil, conn.getError(rv)
 		}
 
-		return &Stmt{conn: conn, stmt: *stmt, ctx: ctx, releaseMode: C.OCI_DEFAULT}, nil
+		newStmt := &Stmt{conn: conn, stmt: *stmt, ctx: ctx, releaseMode: C.OCI_DEFAULT}
+		newStmt.getBindInfo()
+		return newStmt, nil
 	}
 
 	if rv := C.OCIStmtPrepare2(
@@ -144,7 +149,9 @@ func (conn *Conn) PrepareContext(ctx context.Context, query string) (driver.Stmt
 		return nil, conn.getError(rv)
 	}
 
-	return &Stmt{conn: conn, stmt: *stmt, ctx: ctx, releaseMode: C.OCI_DEFAULT, cacheKey: query}, nil
+	newStmt := &Stmt{conn: conn, stmt: *stmt, ctx: ctx, releaseMode: C.OCI_DEFAULT, cacheKey: query}
+	newStmt.getBindInfo()
+	return newStmt, nil
 }
 
 // Begin starts a transaction
@@ -217,17 +224,22 @@ func (conn *Conn) getError(result C.sword) error {
 
 // ociGetError calls OCIErrorGet then returs error code and text
 func (conn *Conn) ociGetError() (int, error) {
+	return ociErrorGet(conn.errHandle)
+}
+
+// ociErrorGet calls OCIErrorGet on the error handle then returs error code and text
+func ociErrorGet(errHandle *C.OCIError) (int, error) {
 	var errorCode C.sb4
 	errorText := make([]byte, 1024)
 
 	result := C.OCIErrorGet(
-		unsafe.Pointer(conn.errHandle), // error handle
-		1,                              // status record number, starts from 1
-		nil,                            // sqlstate, not supported in release 8.x or later
-		&errorCode,                     // error code
-		(*C.OraText)(&errorText[0]),    // error message text
-		1024,                           // size of the buffer provided in number of bytes
-		C.OCI_HTYPE_ERROR,              // type of the handle (OCI_HTYPE_ERR or OCI_HTYPE_ENV)
+		unsafe.Pointer(errHandle),   // error handle
+		1,                           // status record number, starts from 1
+		nil,                         // sqlstate, not supported in release 8.x or later
+		&errorCode,                  // error code
+		(*C.OraText)(&errorText[0]), // error message text
+		1024,                        // size of the buffer provided in number of bytes
+		C.OCI_HTYPE_ERROR,           // type of the handle (OCI_HTYPE_ERR or OCI_HTYPE_ENV)
 	)
 	if result != C.OCI_SUCCESS {
 		return 3114, errors.New("OCIErrorGet failed")
@@ -338,6 +350,27 @@ func (conn *Conn) ociDescriptorAlloc(descriptorType C.ub4, size C.size_t) (*unsa
 	return descriptor, nil, nil
 }
 
+// ociDescriptorArrayAlloc calls OCIDescriptorAlloc for each element of a C array of descriptor pointers then returns the array and error.
+// The array must be freed with freeBufferArray.
+func (conn *Conn) ociDescriptorArrayAlloc(descriptorType C.ub4, dataType C.ub2, size int) (unsafe.Pointer, error) {
+	buffer := C.malloc(C.size_t(size) * C.size_t(sizeOfNilPointer))
+	descriptors := (*[1 << 27]unsafe.Pointer)(buffer)[:size:size]
+	for i := 0; i < size; i++ {
+		descriptors[i] = nil
+	}
+
+	for i := 0; i < size; i++ {
+		descriptor, _, err := conn.ociDescriptorAlloc(descriptorType, 0)
+		if err != nil {
+			freeBufferArray(buffer, dataType, size)
+			return nil, err
+		}
+		descriptors[i] = *descriptor
+	}
+
+	return buffer, nil
+}
+
 // ociLobCreateTemporary calls OCILobCreateTemporary then returns error
 func (conn *Conn) ociLobCreateTemporary(lobLocator *C.OCILobLocator, form C.ub1, lobType C.ub1) error {
 
@@ -504,36 +537,58 @@ func (conn *Conn) ociDateTimeToTime(dateTime *C.OCIDateTime, ociDateTimeHasTimeZ
 	}
 
 	// get OCI time zone offset
-	var timeZoneHour C.sb1
-	var timeZoneMin C.sb1
-	result = C.OCIDateTimeGetTimeZoneOffset(
-		unsafe.Pointer(conn.env), // environment handle
-		conn.errHandle,           // error handle
-		dateTime,                 // pointer to an OCIDateTime
-		&timeZoneHour,            // time zone hour
-		&timeZoneMin,             // time zone minute
-	)
-	err = conn.getError(result)
+	offset, err := conn.ociDateTimeOffset(dateTime)
 	if err != nil {
 		return nil, err
 	}
 
 	// return Go Time using OCI time zone offset
 	aTime := time.Date(int(year), time.Month(month), int(day), int(hour), int(min), int(sec), int(fsec),
-		timezoneToLocation(int64(timeZoneHour), int64(timeZoneMin)))
+		timezoneToLocation(int64(offset/3600), int64((offset%3600)/60)))
+
+	// use the time zone region if it has the same offset as Oracle
+	location := conn.ociDateTimeRegion(dateTime)
+	if location != nil {
+		if _, regionOffset := aTime.In(location).Zone(); regionOffset == offset {
+			aTime = aTime.In(location)
+		}
+	}
 	return &aTime, nil
 }
 
 // timeToOCIDateTime coverts Go Time to OCIDateTime
 func (conn *Conn) timeToOCIDateTime(aTime *time.Time) (*unsafe.Pointer, error) {
+	return conn.timeToOCIDateTimeType(aTime, C.OCI_DTYPE_TIMESTAMP_TZ)
+}
+
+// timeToOCIDateTimeType coverts Go Time to an OCIDateTime of the descriptor type:
+// OCI_DTYPE_TIMESTAMP, OCI_DTYPE_TIMESTAMP_TZ, or OCI_DTYPE_TIMESTAMP_LTZ
+func (conn *Conn) timeToOCIDateTimeType(aTime *time.Time, descriptorType C.ub4) (*unsafe.Pointer, error) {
 	var err error
 	var dateTimePP *unsafe.Pointer
-	dateTimePP, _, err = conn.ociDescriptorAlloc(C.OCI_DTYPE_TIMESTAMP_TZ, 0)
+	dateTimePP, _, err = conn.ociDescriptorAlloc(descriptorType, 0)
 	if err != nil {
 		return nil, err
 	}
 	dateTimeP := (*C.OCIDateTime)(*dateTimePP)
 
+	// construct with the time zone region name if the time location is a region, like America/New_York,
+	// so Oracle keeps the daylight saving time rules. If Oracle does not know the region
+	// or the region offset differs from the Go offset, construct with the offset.
+	if descriptorType != C.OCI_DTYPE_TIMESTAMP {
+		region := timeZoneRegion(aTime.Location())
+		if region != "" {
+			err = conn.ociDateTimeConstruct(dateTimeP, aTime, region)
+			if err == nil {
+				var offset int
+				offset, err = conn.ociDateTimeOffset(dateTimeP)
+				if _, goOffset := aTime.Zone(); err == nil && offset == goOffset {
+					return dateTimePP, nil
+				}
+			}
+		}
+	}
+
 	// make time zone string formated: [+|-][HH:MM]
 	_, offset := aTime.Zone()
 	timeZone := make([]byte, 0, 6)
@@ -550,26 +605,247 @@ func (conn *Conn) timeToOCIDateTime(aTime *time.Time) (*unsafe.Pointer, error) {
 	// minutes
 	timeZone = appendSmallInt(timeZone, offset/60)
 
+	err = conn.ociDateTimeConstruct(dateTimeP, aTime, string(timeZone))
+	if err != nil {
+		C.OCIDescriptorFree(*dateTimePP, descriptorType)
+		return nil, err
+	}
+
+	return dateTimePP, nil
+}
+
+// ociDateTimeConstruct calls OCIDateTimeConstruct to set the OCIDateTime to the Go Time in the time zone:
+// a region name or an offset formated [+|-][HH:MM]
+func (conn *Conn) ociDateTimeConstruct(dateTime *C.OCIDateTime, aTime *time.Time, timeZone string) error {
+	timeZoneP := cString(timeZone)
+	defer C.free(unsafe.Pointer(timeZoneP))
+
 	result := C.OCIDateTimeConstruct(
-		unsafe.Pointer(conn.env),   // environment handle
-		conn.errHandle,             // error handle
-		dateTimeP,                  // an OCIDateTime pointer
-		C.sb2(aTime.Year()),        // year
-		C.ub1(aTime.Month()),       // month
-		C.ub1(aTime.Day()),         // day
-		C.ub1(aTime.Hour()),        // hour
-		C.ub1(aTime.Minute()),      // minute
-		C.ub1(aTime.Second()),      // second
-		C.ub4(aTime.Nanosecond()),  // fractional second
-		(*C.OraText)(&timeZone[0]), // time zone string formated: [+|-][HH:MM]
-		C.size_t(6),                //  time zone string length
+		unsafe.Pointer(conn.env),  // environment handle
+		conn.errHandle,            // error handle
+		dateTime,                  // an OCIDateTime pointer
+		C.sb2(aTime.Year()),       // year
+		C.ub1(aTime.Month()),      // month
+		C.ub1(aTime.Day()),        // day
+		C.ub1(aTime.Hour()),       // hour
+		C.ub1(aTime.Minute()),     // minute
+		C.ub1(aTime.Second()),     // second
+		C.ub4(aTime.Nanosecond()), // fractional second
+		(*C.OraText)(timeZoneP),   // time zone region name or offset
+		C.size_t(len(timeZone)),   // time zone string length
+	)
+	return conn.getError(result)
+}
+
+// ociDateTimeOffset returns the time zone offset in seconds of an OCIDateTime with time zone
+func (conn *Conn) ociDateTimeOffset(dateTime *C.OCIDateTime) (int, error) {
+	var timeZoneHour C.sb1
+	var timeZoneMin C.sb1
+	result := C.OCIDateTimeGetTimeZoneOffset(
+		unsafe.Pointer(conn.env), // environment handle
+		conn.errHandle,           // error handle
+		dateTime,                 // pointer to an OCIDateTime
+		&timeZoneHour,            // time zone hour
+		&timeZoneMin,             // time zone minute
+	)
+	err := conn.getError(result)
+	if err != nil {
+		return 0, err
+	}
+	return (3600 * int(timeZoneHour)) + (60 * int(timeZoneMin)), nil
+}
+
+// ociDateTimeRegion returns the time location of the time zone region name of an OCIDateTime with time zone.
+// Returns nil if the time zone is an offset, or the region cannot be loaded.
+func (conn *Conn) ociDateTimeRegion(dateTime *C.OCIDateTime) *time.Location {
+	buffer := make([]byte, 128)
+	bufferLen := C.ub4(len(buffer))
+	result := C.OCIDateTimeGetTimeZoneName(
+		unsafe.Pointer(conn.env), // environment handle
+		conn.errHandle,           // error handle
+		dateTime,                 // pointer to an OCIDateTime
+		(*C.ub1)(&buffer[0]),     // buffer for the time zone name
+		&bufferLen,               // size of the buffer, set to the length of the name
+	)
+	if result != C.OCI_SUCCESS || bufferLen < 1 || int(bufferLen) > len(buffer) {
+		return nil
+	}
+	return regionLocation(string(buffer[:bufferLen]))
+}
+
+// timeToOCIDate coverts Go Time to an OCIDate in the time location, the caller must free the OCIDate
+func (conn *Conn) timeToOCIDate(aTime time.Time) *C.OCIDate {
+	date := (*C.OCIDate)(C.malloc(C.sizeof_OCIDate))
+	conn.setOCIDate(date, aTime)
+	return date
+}
+
+// setOCIDate sets the OCIDate to Go Time in the time location
+func (conn *Conn) setOCIDate(date *C.OCIDate, aTime time.Time) {
+	aTime = aTime.In(conn.timeLocation)
+	date.OCIDateYYYY = C.sb2(aTime.Year())
+	date.OCIDateMM = C.ub1(aTime.Month())
+	date.OCIDateDD = C.ub1(aTime.Day())
+	date.OCIDateTime.OCITimeHH = C.ub1(aTime.Hour())
+	date.OCIDateTime.OCITimeMI = C.ub1(aTime.Minute())
+	date.OCIDateTime.OCITimeSS = C.ub1(aTime.Second())
+}
+
+// ociDateToTime coverts an OCIDate to Go Time in the time location
+func (conn *Conn) ociDateToTime(date *C.OCIDate) time.Time {
+	return time.Date(int(date.OCIDateYYYY), time.Month(date.OCIDateMM), int(date.OCIDateDD),
+		int(date.OCIDateTime.OCITimeHH), int(date.OCIDateTime.OCITimeMI), int(date.OCIDateTime.OCITimeSS), 0, conn.timeLocation)
+}
+
+// setSessionTimeZone sets the session time zone with alter session
+func (conn *Conn) setSessionTimeZone(timeZone string) error {
+	stmt, err := conn.PrepareContext(context.Background(), "alter session set time_zone = '"+strings.Replace(timeZone, "'", "''", -1)+"'")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	_, err = stmt.(*Stmt).ExecContext(context.Background(), nil)
+	return err
+}
+
+// ociClientVersion calls OCIClientVersion then returns the major version of the client library
+func ociClientVersion() int {
+	var major, minor, update, patch, portUpdate C.sword
+	C.OCIClientVersion(&major, &minor, &update, &patch, &portUpdate)
+	return int(major)
+}
+
+// ociServerRelease calls OCIServerRelease then returns the major version of the server
+func (conn *Conn) ociServerRelease() (int, error) {
+	var version C.ub4
+	buffer := make([]byte, 512)
+	result := C.OCIServerRelease(
+		unsafe.Pointer(conn.svc), // service context handle
+		conn.errHandle,           // error handle
+		(*C.OraText)(&buffer[0]), // buffer in which the release string is returned
+		C.ub4(len(buffer)),       // length of the buffer
+		C.OCI_HTYPE_SVCCTX,       // type of handle
+		&version,                 // release version
+	)
+	err := conn.getError(result)
+	if err != nil {
+		return 0, err
+	}
+	return int((version >> 24) & 0xFF), nil
+}
+
+// durationToOCIInterval coverts Go Duration to an OCIInterval day to second
+func (conn *Conn) durationToOCIInterval(duration time.Duration) (*unsafe.Pointer, error) {
+	intervalPP, _, err := conn.ociDescriptorAlloc(C.OCI_DTYPE_INTERVAL_DS, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	days := duration / (24 * time.Hour)
+	duration -= days * 24 * time.Hour
+	hours := duration / time.Hour
+	duration -= hours * time.Hour
+	minutes := duration / time.Minute
+	duration -= minutes * time.Minute
+	seconds := duration / time.Second
+	duration -= seconds * time.Second
+
+	result := C.OCIIntervalSetDaySecond(
+		unsafe.Pointer(conn.env),      // environment handle
+		conn.errHandle,                // error handle
+		C.sb4(days),                   // days
+		C.sb4(hours),                  // hours
+		C.sb4(minutes),                // minutes
+		C.sb4(seconds),                // seconds
+		C.sb4(duration),               // fractional seconds
+		(*C.OCIInterval)(*intervalPP), // interval
 	)
 	err = conn.getError(result)
 	if err != nil {
+		C.OCIDescriptorFree(*intervalPP, C.OCI_DTYPE_INTERVAL_DS)
 		return nil, err
 	}
 
-	return dateTimePP, nil
+	return intervalPP, nil
+}
+
+// ociIntervalToDuration coverts an OCIInterval day to second to Go Duration
+func (conn *Conn) ociIntervalToDuration(interval *C.OCIInterval) (time.Duration, error) {
+	var days C.sb4
+	var hours C.sb4
+	var minutes C.sb4
+	var seconds C.sb4
+	var fracSeconds C.sb4
+	result := C.OCIIntervalGetDaySecond(
+		unsafe.Pointer(conn.env), // environment handle
+		conn.errHandle,           // error handle
+		&days,                    // days
+		&hours,                   // hours
+		&minutes,                 // minutes
+		&seconds,                 // seconds
+		&fracSeconds,             // fractional seconds
+		interval,                 // interval
+	)
+	err := conn.getError(result)
+	if err != nil {
+		return 0, err
+	}
+
+	// max Duration is a little over 106751 days
+	if days > 106751 || days < -106751 {
+		return 0, fmt.Errorf("interval of %v days overflows Duration", days)
+	}
+	duration := time.Duration(days) * 24 * time.Hour
+	rest := (time.Duration(hours) * time.Hour) + (time.Duration(minutes) * time.Minute) +
+		(time.Duration(seconds) * time.Second) + time.Duration(fracSeconds)
+	if (rest > 0 && duration+rest < duration) || (rest < 0 && duration+rest > duration) {
+		return 0, fmt.Errorf("interval of %v days overflows Duration", days)
+	}
+
+	return duration + rest, nil
+}
+
+// monthsToOCIInterval coverts months to an OCIInterval year to month
+func (conn *Conn) monthsToOCIInterval(months int64) (*unsafe.Pointer, error) {
+	intervalPP, _, err := conn.ociDescriptorAlloc(C.OCI_DTYPE_INTERVAL_YM, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	result := C.OCIIntervalSetYearMonth(
+		unsafe.Pointer(conn.env),      // environment handle
+		conn.errHandle,                // error handle
+		C.sb4(months/12),              // years
+		C.sb4(months%12),              // months
+		(*C.OCIInterval)(*intervalPP), // interval
+	)
+	err = conn.getError(result)
+	if err != nil {
+		C.OCIDescriptorFree(*intervalPP, C.OCI_DTYPE_INTERVAL_YM)
+		return nil, err
+	}
+
+	return intervalPP, nil
+}
+
+// ociIntervalToMonths coverts an OCIInterval year to month to months
+func (conn *Conn) ociIntervalToMonths(interval *C.OCIInterval) (int64, error) {
+	var years C.sb4
+	var months C.sb4
+	result := C.OCIIntervalGetYearMonth(
+		unsafe.Pointer(conn.env), // environment handle
+		conn.errHandle,           // error handle
+		&years,                   // years
+		&months,                  // months
+		interval,                 // interval
+	)
+	err := conn.getError(result)
+	if err != nil {
+		return 0, err
+	}
+
+	return (int64(years) * 12) + int64(months), nil
 }
 
 // appendSmallInt takes small int and returns an appended byte slice
@@ -0,0 +1,159 @@
+package oci8
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"time"
+)
+
+// Time returns the date as a time.Time
+func (date Date) Time() time.Time {
+	return time.Time(date)
+}
+
+// Value implements driver.Valuer, the value is the time.Time of the date
+func (date Date) Value() (driver.Value, error) {
+	return time.Time(date), nil
+}
+
+// Scan implements sql.Scanner so a date or timestamp column can be scanned into a Date
+func (date *Date) Scan(src interface{}) error {
+	aTime, err := scanTime(src, "Date")
+	if err != nil {
+		return err
+	}
+	*date = Date(aTime)
+	return nil
+}
+
+// Time returns the timestamp as a time.Time
+func (timestamp Timestamp) Time() time.Time {
+	return time.Time(timestamp)
+}
+
+// Value implements driver.Valuer, the value is the time.Time of the timestamp
+func (timestamp Timestamp) Value() (driver.Value, error) {
+	return time.Time(timestamp), nil
+}
+
+// Scan implements sql.Scanner so a date or timestamp column can be scanned into a Timestamp
+func (timestamp *Timestamp) Scan(src interface{}) error {
+	aTime, err := scanTime(src, "Timestamp")
+	if err != nil {
+		return err
+	}
+	*timestamp = Timestamp(aTime)
+	return nil
+}
+
+// Time returns the timestamp as a time.Time
+func (timestamp TimestampTZ) Time() time.Time {
+	return time.Time(timestamp)
+}
+
+// Value implements driver.Valuer, the value is the time.Time of the timestamp
+func (timestamp TimestampTZ) Value() (driver.Value, error) {
+	return time.Time(timestamp), nil
+}
+
+// Scan implements sql.Scanner so a date or timestamp column can be scanned into a TimestampTZ
+func (timestamp *TimestampTZ) Scan(src interface{}) error {
+	aTime, err := scanTime(src, "TimestampTZ")
+	if err != nil {
+		return err
+	}
+	*timestamp = TimestampTZ(aTime)
+	return nil
+}
+
+// scanTime returns the time.Time of a scan source, the name is the type scanned into for errors
+func scanTime(src interface{}, name string) (time.Time, error) {
+	switch value := src.(type) {
+	case time.Time:
+		return value, nil
+	case nil:
+		return time.Time{}, fmt.Errorf("cannot scan NULL into %v, use Null%v", name, name)
+	}
+	return time.Time{}, fmt.Errorf("cannot scan %T into %v", src, name)
+}
+
+// Scan implements sql.Scanner so a nullable date or timestamp column can be scanned into a NullDate
+func (date *NullDate) Scan(src interface{}) error {
+	if src == nil {
+		date.Date, date.Valid = Date{}, false
+		return nil
+	}
+	err := date.Date.Scan(src)
+	date.Valid = err == nil
+	return err
+}
+
+// Value implements driver.Valuer, the value is nil for NULL otherwise the time.Time of the Date
+func (date NullDate) Value() (driver.Value, error) {
+	if !date.Valid {
+		return nil, nil
+	}
+	return date.Date.Value()
+}
+
+// bindValue returns the Date to bind, or nil for NULL
+func (date NullDate) bindValue() interface{} {
+	if !date.Valid {
+		return nil
+	}
+	return date.Date
+}
+
+// Scan implements sql.Scanner so a nullable date or timestamp column can be scanned into a NullTimestamp
+func (timestamp *NullTimestamp) Scan(src interface{}) error {
+	if src == nil {
+		timestamp.Timestamp, timestamp.Valid = Timestamp{}, false
+		return nil
+	}
+	err := timestamp.Timestamp.Scan(src)
+	timestamp.Valid = err == nil
+	return err
+}
+
+// Value implements driver.Valuer, the value is nil for NULL otherwise the time.Time of the Timestamp
+func (timestamp NullTimestamp) Value() (driver.Value, error) {
+	if !timestamp.Valid {
+		return nil, nil
+	}
+	return timestamp.Timestamp.Value()
+}
+
+// bindValue returns the Timestamp to bind, or nil for NULL
+func (timestamp NullTimestamp) bindValue() interface{} {
+	if !timestamp.Valid {
+		return nil
+	}
+	return timestamp.Timestamp
+}
+
+// Scan implements sql.Scanner so a nullable date or timestamp column can be scanned into a NullTimestampTZ
+func (timestamp *NullTimestampTZ) Scan(src interface{}) error {
+	if src == nil {
+		timestamp.TimestampTZ, timestamp.Valid = TimestampTZ{}, false
+		return nil
+	}
+	err := timestamp.TimestampTZ.Scan(src)
+	timestamp.Valid = err == nil
+	return err
+}
+
+// Value implements driver.Valuer, the value is nil for NULL otherwise the time.Time of the TimestampTZ
+func (timestamp NullTimestampTZ) Value() (driver.Value, error) {
+	if !timestamp.Valid {
+		return nil, nil
+	}
+	return timestamp.TimestampTZ.Value()
+}
+
+// bindValue returns the TimestampTZ to bind, or nil for NULL
+func (timestamp NullTimestampTZ) bindValue() interface{} {
+	if !timestamp.Valid {
+		return nil
+	}
+	return timestamp.TimestampTZ
+}
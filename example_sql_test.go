@@ -735,25 +735,25 @@ func Example_sqlRowid() {
 
 	// insert row and get rowid from returning
 	var rowid1 string // rowid will be put into here
-	var result sql.Result
 	query = "insert into " + tableName + " ( A ) values (:1) returning rowid into :rowid1"
 	ctx, cancel = context.WithTimeout(context.Background(), 55*time.Second)
-	result, err = db.ExecContext(ctx, query, 1, sql.Named("rowid1", sql.Out{Dest: &rowid1}))
+	_, err = db.ExecContext(ctx, query, 1, sql.Named("rowid1", sql.Out{Dest: &rowid1}))
 	cancel()
 	if err != nil {
 		fmt.Println("ExecContext error is not nil:", err)
 		return
 	}
 
-	// get rowid from LastInsertId
+	// update row and get rowid from ExecRowID
 	var rowid2 string // rowid will be put into here
-	var id int64
-	id, err = result.LastInsertId()
+	query = "update " + tableName + " set A = 2 where A = :1"
+	ctx, cancel = context.WithTimeout(context.Background(), 55*time.Second)
+	rowid2, _, err = oci8.ExecRowID(ctx, db, query, 1)
+	cancel()
 	if err != nil {
-		fmt.Println("LastInsertId error is not nil:", err)
+		fmt.Println("ExecRowID error is not nil:", err)
 		return
 	}
-	rowid2 = oci8.GetLastInsertId(id)
 
 	// select rowid
 	var rowid3 string // rowid will be put into here
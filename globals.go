@@ -12,10 +12,11 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"io"
 	"io/ioutil"
 	"log"
+	"math/big"
 	"reflect"
-	"regexp"
 	"strconv"
 	"sync"
 	"time"
@@ -26,21 +27,34 @@ const (
 	lobBufferSize      = 4000
 	useOCISessionBegin = true
 	sizeOfNilPointer   = unsafe.Sizeof(unsafe.Pointer(nil))
+	defaultTimeBind    = C.SQLT_TIMESTAMP_TZ
+	lobStreamSize      = 32768 // size of the buffer of Lob ReadFrom and of binding a Lob from an io.Reader
+	bfileDirectorySize = 128   // maximum length of a BFILE directory alias
+	bfileFilenameSize  = 255   // maximum length of a BFILE filename
 )
 
 type (
 	// DSN is Oracle Data Source Name
 	DSN struct {
-		Connect              string
-		Username             string
-		Password             string
-		prefetchRows         C.ub4
-		prefetchMemory       C.ub4
-		timeLocation         *time.Location
-		transactionMode      C.ub4
-		enableQMPlaceholders bool
-		operationMode        C.ub4
-		stmtCacheSize        C.ub4
+		Connect                  string
+		Username                 string
+		Password                 string
+		prefetchRows             C.ub4
+		prefetchMemory           C.ub4
+		timeLocation             *time.Location
+		transactionMode          C.ub4
+		enableQMPlaceholders     bool
+		enableDollarPlaceholders bool
+		enableAtPlaceholders     bool
+		operationMode            C.ub4
+		stmtCacheSize            C.ub4
+		fetchArraySize           int
+		nativeBool               bool
+		exactNumber              bool
+		timeBind                 C.ub2
+		sessionTimeZone          string
+		lobLocator               bool
+		lobPrefetchSize          C.ub4
 	}
 
 	// DriverStruct is Oracle driver struct
@@ -58,22 +72,33 @@ type (
 
 	// Conn is Oracle connection
 	Conn struct {
-		svc                  *C.OCISvcCtx
-		srv                  *C.OCIServer
-		env                  *C.OCIEnv
-		errHandle            *C.OCIError
-		usrSession           *C.OCISession
-		txHandle             *C.OCITrans
-		prefetchRows         C.ub4
-		prefetchMemory       C.ub4
-		transactionMode      C.ub4
-		operationMode        C.ub4
-		stmtCacheSize        C.ub4
-		inTransaction        bool
-		enableQMPlaceholders bool
-		closed               bool
-		timeLocation         *time.Location
-		logger               *log.Logger
+		svc                      *C.OCISvcCtx
+		srv                      *C.OCIServer
+		env                      *C.OCIEnv
+		errHandle                *C.OCIError
+		usrSession               *C.OCISession
+		txHandle                 *C.OCITrans
+		prefetchRows             C.ub4
+		prefetchMemory           C.ub4
+		transactionMode          C.ub4
+		operationMode            C.ub4
+		stmtCacheSize            C.ub4
+		fetchArraySize           int
+		nativeBool               bool  // bool values are bound as SQLT_BOL when the client and server versions support it
+		clientVersion            int   // major version of the client library, set if nativeBool
+		serverVersion            int   // major version of the server, set if nativeBool
+		exactNumber              bool  // NUMBER columns that may not fit in an int64 are fetched as Number
+		timeBind                 C.ub2 // data type time.Time values are bound as: SQLT_ODT, SQLT_TIMESTAMP, or SQLT_TIMESTAMP_TZ
+		lobLocator               bool  // LOB columns are fetched as *Lob
+		lobPrefetchSize          C.ub4 // size of the LOB data fetched with the locators, 0 to not prefetch
+		inTransaction            bool
+		enableQMPlaceholders     bool
+		enableDollarPlaceholders bool
+		enableAtPlaceholders     bool
+		closed                   bool
+		timeLocation             *time.Location
+		logger                   *log.Logger
+		objectTypes              map[string]*objectType // described object and collection types by SCHEMA.NAME
 	}
 
 	// Tx is Oracle transaction
@@ -89,13 +114,193 @@ type (
 		ctx         context.Context
 		cacheKey    string // if statement caching is enabled, this is the key for this statement into the cache
 		releaseMode C.ub4
+		options     stmtOptions // options for the next exec or query, set by StmtOption arguments
+		numInput    int         // number of unique binds from the bind info, -1 if unknown
+		bindNames   []string    // unique bind names in statement order from the bind info
+		repeatBinds bool        // true if a bind name is used more than once in a SQL statement
+		exactNumber bool        // NUMBER columns are fetched as Number for this query, set by the ExactNumber option
+		lobLocator  bool        // LOB columns are fetched as *Lob for this query, set by the LobLocator option
+		lobPrefetch int         // size of the LOB data fetched with the locators for this query, set by the LobPrefetchSize option
+	}
+
+	// StmtOption is an option that can be passed as an argument to Exec and Query.
+	// Options are removed from the arguments and only apply to that exec or query.
+	StmtOption interface {
+		apply(options *stmtOptions)
+	}
+
+	stmtOptionFunc func(options *stmtOptions)
+
+	stmtOptions struct {
+		batchErrors    bool
+		rowCounts      *[]int64
+		fetchArraySize int
+		outSize        int
+		exactNumber    bool
+		lobLocator     bool
+		lobPrefetch    int
+	}
+
+	// BatchError is returned by an array DML exec with the BatchErrors option when one or more rows failed.
+	// The statement has run even though Exec returns a nil Result: the rows that did not fail have been applied,
+	// and committed unless in a transaction, the out binds have been set, and the RowCounts option slice has been written.
+	BatchError struct {
+		// RowsAffected is the number of rows applied
+		RowsAffected int64
+		// Errors are the errors of the rows that failed
+		Errors []RowError
+	}
+
+	// ErrTruncated is returned by Exec when the value of a string or []byte out bind is larger than the out bind buffer.
+	// The destination is set to the truncated value. Use the OutSize option to increase the buffer size.
+	ErrTruncated struct {
+		// Param is the bind name, or the bind position if bound by position
+		Param string
+		// ActualLen is the length in bytes of the value before truncation, -1 if unknown
+		ActualLen int
+	}
+
+	// RowError is the error of a single row of an array DML exec
+	RowError struct {
+		// Offset is the index of the row in the bound arrays
+		Offset int
+		// Code is the ORA error code
+		Code int
+		// Err is the Oracle error
+		Err error
+	}
+
+	// Object is an Oracle object type instance.
+	// TypeName is the optionally schema qualified name of the object type, it is required to bind an Object.
+	// Attributes are keyed by attribute name; a nil Attributes is a null object.
+	Object struct {
+		TypeName   string
+		Attributes map[string]interface{}
+	}
+
+	// Collection is an Oracle nested table or varray instance.
+	// TypeName is the optionally schema qualified name of the collection type, it is required to bind a Collection.
+	// A nil Elements is a null collection.
+	Collection struct {
+		TypeName string
+		Elements []interface{}
+	}
+
+	// Number is an exact Oracle NUMBER as a decimal string, like -123.45, so no precision is lost converting to float64.
+	// NUMBER columns are fetched as Number with the exact_number DSN parameter or the ExactNumber option.
+	// A Number is bound as a NUMBER, and can be an out bind destination.
+	Number string
+
+	// IntervalDS is an Oracle INTERVAL DAY TO SECOND, it converts to and from time.Duration.
+	// INTERVAL DAY TO SECOND columns are fetched as IntervalDS, and an IntervalDS is bound as an INTERVAL DAY TO SECOND.
+	IntervalDS time.Duration
+
+	// IntervalYM is an Oracle INTERVAL YEAR TO MONTH as a number of months.
+	// INTERVAL YEAR TO MONTH columns are fetched as IntervalYM, and an IntervalYM is bound as an INTERVAL YEAR TO MONTH.
+	IntervalYM int64
+
+	// Date is a time bound as an Oracle DATE, in the loc DSN time location, without fractional seconds.
+	// Use Date to compare with a DATE column so an index on the column can be used.
+	Date time.Time
+
+	// Timestamp is a time bound as an Oracle TIMESTAMP, in the loc DSN time location.
+	Timestamp time.Time
+
+	// TimestampTZ is a time bound as an Oracle TIMESTAMP WITH TIME ZONE, the default for time.Time.
+	TimestampTZ time.Time
+
+	// NullIntervalDS is an IntervalDS that may be NULL, like sql.NullInt64. Valid is false for NULL.
+	NullIntervalDS struct {
+		IntervalDS IntervalDS
+		Valid      bool
+	}
+
+	// NullIntervalYM is an IntervalYM that may be NULL, like sql.NullInt64. Valid is false for NULL.
+	NullIntervalYM struct {
+		IntervalYM IntervalYM
+		Valid      bool
+	}
+
+	// NullDate is a Date that may be NULL, like sql.NullTime. Valid is false for NULL.
+	NullDate struct {
+		Date  Date
+		Valid bool
+	}
+
+	// NullTimestamp is a Timestamp that may be NULL, like sql.NullTime. Valid is false for NULL.
+	NullTimestamp struct {
+		Timestamp Timestamp
+		Valid     bool
+	}
+
+	// NullTimestampTZ is a TimestampTZ that may be NULL, like sql.NullTime. Valid is false for NULL.
+	NullTimestampTZ struct {
+		TimestampTZ TimestampTZ
+		Valid       bool
+	}
+
+	// nullValue is implemented by the Null types, bindValue returns the value to bind or nil for NULL
+	nullValue interface {
+		bindValue() interface{}
+	}
+
+	// Clob is an out bind destination that is bound as a temporary CLOB,
+	// so the returned value is not limited to the size of a VARCHAR2.
+	Clob string
+
+	// Blob is an out bind destination that is bound as a temporary BLOB,
+	// so the returned value is not limited to the size of a RAW.
+	Blob []byte
+
+	// NString is a string that is bound in the national character set, for NCHAR, NVARCHAR2, and NCLOB columns.
+	// A *NString can be an out bind destination.
+	NString string
+
+	// Lob is a BLOB, CLOB, or NCLOB locator that reads and writes the LOB in pieces so the LOB does not need to fit in memory.
+	// Queries with the LobLocator option or the lob_fetch=locator DSN parameter return a *Lob for LOB columns,
+	// and a *Lob can be an out bind destination.
+	// A Lob made with NewBlob or NewClob is bound as a temporary LOB with the content streamed from an io.Reader.
+	// Offsets and sizes are in bytes for a BLOB and in characters for a CLOB or NCLOB.
+	// A Lob uses the connection it came from, so use it before the connection is used for something else,
+	// for example inside a transaction or a sql.Conn. Close frees the locator.
+	// A *Lob returned by a query is valid until the rows are closed, a Lob it is scanned into is valid until the Lob is closed.
+	Lob struct {
+		conn    *Conn
+		locator *C.OCILobLocator
+		isClob  bool
+		form    C.ub1     // character set form: SQLCS_IMPLICIT or SQLCS_NCHAR
+		offset  int64     // offset of the next Read or Write
+		opened  bool      // opened with Open
+		reader  io.Reader // content of a Lob made with NewBlob or NewClob, read when bound
+	}
+
+	// BFile is a read-only BFILE locator, a file in a directory on the database server.
+	// Queries return a *BFile for BFILE columns, valid until the rows are closed unless scanned into a BFile.
+	// A BFile made with NewBFile is bound like BFILENAME(directory, filename).
+	// The file is opened by the first read, or by Open. Close closes the file and frees the locator.
+	// A BFile uses the connection it came from, so use it before the connection is used for something else.
+	BFile struct {
+		lob       Lob // the BFILE locator, read as a BLOB
+		directory string
+		filename  string
 	}
 
 	// Rows is Oracle rows
 	Rows struct {
-		stmt    *Stmt
-		defines []defineStruct
-		closed  bool
+		stmt        *Stmt
+		defines     []defineStruct
+		closed      bool
+		lobs        []io.Closer // Lobs and BFiles returned by Next, closed on close unless scanned into a Lob or BFile, and temporary LOBs of the binds
+		arraySize   int         // number of rows fetched per OCIStmtFetch2 call
+		rowsFetched int         // number of rows in the defines from the last fetch
+		currentRow  int         // index of the current row in the defines
+		fetchDone   bool        // the last fetch returned OCI_NO_DATA
+		freeHandle  bool        // the statement handle is a REF CURSOR out bind owned by the rows and is freed on close
+
+		implicitStmt        *Stmt // the PL/SQL statement that returned implicit results, nil if the rows are not implicit results
+		implicitResultCount int   // number of implicit results returned by implicitStmt
+		implicitResultIndex int   // number of implicit results retrieved, the current result set is implicitResultIndex - 1
+		implicitArraySize   int   // fetch array size for the implicit result sets
 	}
 
 	// Result is Oracle result
@@ -107,25 +312,75 @@ type (
 		stmt            *Stmt
 	}
 
+	// RowIDResult is implemented by Result, the driver.Result of this driver.
+	// database/sql does not return the driver.Result, so with database/sql use ExecRowID instead.
+	RowIDResult interface {
+		// RowID returns the rowid of the last row affected by an insert, update, or delete
+		RowID() (string, error)
+	}
+
+	// ContextExecer is implemented by *sql.DB, *sql.Conn, and *sql.Tx
+	ContextExecer interface {
+		ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	}
+
 	defineStruct struct {
-		name         string
-		dataType     C.ub2
-		pbuf         unsafe.Pointer
-		maxSize      C.sb4
-		length       *C.ub2
-		indicator    *C.sb2
-		defineHandle *C.OCIDefine
-		subDefines   []defineStruct
+		name            string
+		dataType        C.ub2
+		pbuf            unsafe.Pointer
+		maxSize         C.sb4
+		length          *C.ub2
+		indicator       *C.sb2
+		defineHandle    *C.OCIDefine
+		subDefines      []defineStruct
+		arraySize       int
+		bigInteger      bool           // NUMBER(p, 0) with p > 18 fetched as SQLT_VNU, returned as int64 if it fits otherwise as Number
+		objectType      *objectType    // the object or collection type of a SQLT_NTY define
+		objectInstance  unsafe.Pointer // C memory pointer to the object instance pointer of a SQLT_NTY define
+		objectIndicator unsafe.Pointer // C memory pointer to the null structure pointer of a SQLT_NTY define
+		charsetForm     C.ub1          // character set form of the column: SQLCS_IMPLICIT or SQLCS_NCHAR
 	}
 
 	bindStruct struct {
-		dataType   C.ub2
-		pbuf       unsafe.Pointer
-		maxSize    C.sb4
-		length     *C.ub2
-		indicator  *C.sb2
-		bindHandle *C.OCIBind
-		out        sql.Out
+		dataType        C.ub2
+		pbuf            unsafe.Pointer
+		maxSize         C.sb4
+		length          *C.ub2
+		indicator       *C.sb2
+		bindHandle      *C.OCIBind
+		out             sql.Out
+		param           string // bind name, or bind position if bound by position
+		isArray         bool
+		arrayLen        int              // number of elements allocated for an array bind
+		maxArrayLen     C.ub4            // max number of elements of a PL/SQL array, 0 if not a PL/SQL array
+		curArrayLen     *C.ub4           // current number of elements of a PL/SQL array, nil if not a PL/SQL array
+		objectType      *objectType      // the object or collection type of a SQLT_NTY bind
+		objectInstance  unsafe.Pointer   // C memory pointer to the object instance pointer of a SQLT_NTY bind
+		objectIndicator unsafe.Pointer   // C memory pointer to the null structure pointer of a SQLT_NTY bind, nil for collections
+		returning       *C.oci8Returning // returned values of a DML RETURNING bind, nil if not a DML RETURNING bind
+		lob             *Lob             // Lob whose locator is bound, the locator belongs to the Lob and is not freed with the bind
+		nchar           bool             // bound in the national character set, for NString and NCLOB binds
+		lobConn         *Conn            // connection of a temporary LOB created for the bind, the LOB is freed with the bind
+		lobReader       io.Reader        // content of a NewBlob, NewClob, or NewNClob Lob, written to the temporary LOB before execute
+	}
+
+	objectType struct {
+		conn               *Conn
+		name               string // SCHEMA.NAME
+		typeName           string // NAME without the schema
+		tdo                *C.OCIType
+		typeCode           C.OCITypeCode
+		collectionTypeCode C.OCITypeCode     // OCI_TYPECODE_TABLE or OCI_TYPECODE_VARRAY for collections
+		attributes         []objectAttribute // attributes of an object type
+		element            *objectAttribute  // element of a collection type
+	}
+
+	objectAttribute struct {
+		name       string
+		cName      *C.OraText
+		typeCode   C.OCITypeCode
+		isInteger  bool        // number attribute with a scale of 0
+		objectType *objectType // type of an object or collection attribute
 	}
 )
 
@@ -145,16 +400,27 @@ var (
 
 	// ErrNoRowid is result has no rowid
 	ErrNoRowid = errors.New("result has no rowid")
+	// ErrNoLastInsertId is returned by LastInsertId, use InsertReturning or ExecRowID instead
+	ErrNoLastInsertId = errors.New("LastInsertId is not supported, use InsertReturning or ExecRowID")
 
-	phre           = regexp.MustCompile(`\?`)
 	defaultCharset = C.ub2(0)
 
-	typeNil       = reflect.TypeOf(nil)
-	typeString    = reflect.TypeOf("a")
-	typeSliceByte = reflect.TypeOf([]byte{})
-	typeInt64     = reflect.TypeOf(int64(1))
-	typeFloat64   = reflect.TypeOf(float64(1))
-	typeTime      = reflect.TypeOf(time.Time{})
+	typeNil         = reflect.TypeOf(nil)
+	typeString      = reflect.TypeOf("a")
+	typeSliceByte   = reflect.TypeOf([]byte{})
+	typeInt64       = reflect.TypeOf(int64(1))
+	typeFloat64     = reflect.TypeOf(float64(1))
+	typeBool        = reflect.TypeOf(false)
+	typeTime        = reflect.TypeOf(time.Time{})
+	typeDate        = reflect.TypeOf(Date{})
+	typeTimestamp   = reflect.TypeOf(Timestamp{})
+	typeTimestampTZ = reflect.TypeOf(TimestampTZ{})
+	typeNumber      = reflect.TypeOf(Number(""))
+	typeBigInt      = reflect.TypeOf(big.Int{})
+	typeIntervalDS  = reflect.TypeOf(IntervalDS(0))
+	typeIntervalYM  = reflect.TypeOf(IntervalYM(0))
+	typeLob         = reflect.TypeOf((*Lob)(nil))
+	typeBFile       = reflect.TypeOf((*BFile)(nil))
 
 	// Driver is the sql driver
 	Driver = &DriverStruct{
@@ -163,6 +429,23 @@ var (
 
 	timeLocations []*time.Location
 
+	// regionLocations caches the time locations of Oracle time zone region names, nil if the region cannot be loaded
+	regionLocations sync.Map
+
+	// objectStructTypes are the Go types registered with RegisterObjectType
+	objectStructTypes = struct {
+		sync.RWMutex
+		typeNames map[reflect.Type]string // Go type to the registered Oracle type name
+		goTypes   map[string]reflect.Type // SCHEMA.NAME, or .NAME if registered without a schema, to the Go type
+	}{
+		typeNames: make(map[reflect.Type]string),
+		goTypes:   make(map[string]reflect.Type),
+	}
+
+	typeObject     = reflect.TypeOf(Object{})
+	typeCollection = reflect.TypeOf(Collection{})
+	typeScanner    = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
+
 	byteBufferPool = sync.Pool{
 		New: func() interface{} {
 			return make([]byte, lobBufferSize)
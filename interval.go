@@ -0,0 +1,161 @@
+package oci8
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Duration returns the interval as a time.Duration
+func (interval IntervalDS) Duration() time.Duration {
+	return time.Duration(interval)
+}
+
+// String returns the interval as an Oracle literal, like INTERVAL '1 02:03:04.500000000' DAY(9) TO SECOND(9)
+func (interval IntervalDS) String() string {
+	return "INTERVAL '" + interval.value() + "' DAY(9) TO SECOND(9)"
+}
+
+// Value implements driver.Valuer, the value is the interval as a day to second string like -1 02:03:04.500000000
+func (interval IntervalDS) Value() (driver.Value, error) {
+	return interval.value(), nil
+}
+
+// value returns the interval as a day to second string
+func (interval IntervalDS) value() string {
+	sign := ""
+	nanoseconds := uint64(interval)
+	if interval < 0 {
+		sign = "-"
+		nanoseconds = uint64(-interval)
+	}
+
+	seconds := nanoseconds / uint64(time.Second)
+	nanoseconds %= uint64(time.Second)
+	minutes := seconds / 60
+	seconds %= 60
+	hours := minutes / 60
+	minutes %= 60
+	days := hours / 24
+	hours %= 24
+
+	return fmt.Sprintf("%s%d %02d:%02d:%02d.%09d", sign, days, hours, minutes, seconds, nanoseconds)
+}
+
+// Scan implements sql.Scanner so an interval day to second column can be scanned into an IntervalDS
+func (interval *IntervalDS) Scan(src interface{}) error {
+	switch value := src.(type) {
+	case IntervalDS:
+		*interval = value
+	case time.Duration:
+		*interval = IntervalDS(value)
+	case int64:
+		*interval = IntervalDS(value)
+	case nil:
+		return errors.New("cannot scan NULL into IntervalDS, use NullIntervalDS")
+	default:
+		return fmt.Errorf("cannot scan %T into IntervalDS", src)
+	}
+	return nil
+}
+
+// Years returns the years of the interval, negative if the interval is negative
+func (interval IntervalYM) Years() int64 {
+	return int64(interval) / 12
+}
+
+// Months returns the months of the interval after the years, negative if the interval is negative
+func (interval IntervalYM) Months() int64 {
+	return int64(interval) % 12
+}
+
+// String returns the interval as an Oracle literal, like INTERVAL '1-02' YEAR(9) TO MONTH
+func (interval IntervalYM) String() string {
+	return "INTERVAL '" + interval.value() + "' YEAR(9) TO MONTH"
+}
+
+// Value implements driver.Valuer, the value is the interval as a year to month string like -1-02
+func (interval IntervalYM) Value() (driver.Value, error) {
+	return interval.value(), nil
+}
+
+// value returns the interval as a year to month string
+func (interval IntervalYM) value() string {
+	sign := ""
+	months := uint64(interval)
+	if interval < 0 {
+		sign = "-"
+		months = uint64(-interval)
+	}
+	return fmt.Sprintf("%s%d-%02d", sign, months/12, months%12)
+}
+
+// Scan implements sql.Scanner so an interval year to month column can be scanned into an IntervalYM
+func (interval *IntervalYM) Scan(src interface{}) error {
+	switch value := src.(type) {
+	case IntervalYM:
+		*interval = value
+	case int64:
+		*interval = IntervalYM(value)
+	case nil:
+		return errors.New("cannot scan NULL into IntervalYM, use NullIntervalYM")
+	default:
+		return fmt.Errorf("cannot scan %T into IntervalYM", src)
+	}
+	return nil
+}
+
+// Scan implements sql.Scanner so a nullable interval day to second column can be scanned into a NullIntervalDS
+func (interval *NullIntervalDS) Scan(src interface{}) error {
+	if src == nil {
+		interval.IntervalDS, interval.Valid = 0, false
+		return nil
+	}
+	err := interval.IntervalDS.Scan(src)
+	interval.Valid = err == nil
+	return err
+}
+
+// Value implements driver.Valuer, the value is nil for NULL otherwise the value of the IntervalDS
+func (interval NullIntervalDS) Value() (driver.Value, error) {
+	if !interval.Valid {
+		return nil, nil
+	}
+	return interval.IntervalDS.Value()
+}
+
+// bindValue returns the IntervalDS to bind, or nil for NULL
+func (interval NullIntervalDS) bindValue() interface{} {
+	if !interval.Valid {
+		return nil
+	}
+	return interval.IntervalDS
+}
+
+// Scan implements sql.Scanner so a nullable interval year to month column can be scanned into a NullIntervalYM
+func (interval *NullIntervalYM) Scan(src interface{}) error {
+	if src == nil {
+		interval.IntervalYM, interval.Valid = 0, false
+		return nil
+	}
+	err := interval.IntervalYM.Scan(src)
+	interval.Valid = err == nil
+	return err
+}
+
+// Value implements driver.Valuer, the value is nil for NULL otherwise the value of the IntervalYM
+func (interval NullIntervalYM) Value() (driver.Value, error) {
+	if !interval.Valid {
+		return nil, nil
+	}
+	return interval.IntervalYM.Value()
+}
+
+// bindValue returns the IntervalYM to bind, or nil for NULL
+func (interval NullIntervalYM) bindValue() interface{} {
+	if !interval.Valid {
+		return nil
+	}
+	return interval.IntervalYM
+}
@@ -0,0 +1,421 @@
+package oci8
+
+// #include "oci8.go.h"
+import "C"
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"unicode/utf8"
+	"unsafe"
+)
+
+// NewBlob returns a Lob that is bound as a temporary BLOB with the content read from reader when the statement is executed.
+// The temporary BLOB is freed after the execute, or when the rows are closed for a query.
+func NewBlob(reader io.Reader) *Lob {
+	return &Lob{reader: reader, form: C.SQLCS_IMPLICIT}
+}
+
+// NewClob returns a Lob that is bound as a temporary CLOB with the content read from reader when the statement is executed.
+// The content is in the client character set, AL32UTF8 unless NLS_LANG is set.
+// A NewClob with a nil reader is a CLOB out bind destination.
+func NewClob(reader io.Reader) *Lob {
+	return &Lob{reader: reader, isClob: true, form: C.SQLCS_IMPLICIT}
+}
+
+// NewNClob returns a Lob that is bound as a temporary NCLOB with the content read from reader when the statement is executed.
+// The content is in the client character set, AL32UTF8 unless NLS_LANG is set.
+// A NewNClob with a nil reader is an NCLOB out bind destination.
+func NewNClob(reader io.Reader) *Lob {
+	return &Lob{reader: reader, isClob: true, form: C.SQLCS_NCHAR}
+}
+
+// newLob returns a Lob with a copy of the locator, so the Lob stays valid when the locator is reused or freed
+func (conn *Conn) newLob(locator *C.OCILobLocator, isClob bool) (*Lob, error) {
+	lobPP, _, err := conn.ociDescriptorAlloc(C.OCI_DTYPE_LOB, 0)
+	if err != nil {
+		return nil, err
+	}
+	result := C.OCILobLocatorAssign(
+		conn.svc,       // service context handle
+		conn.errHandle, // error handle
+		locator,        // source locator
+		(**C.OCILobLocator)(unsafe.Pointer(lobPP)), // destination locator
+	)
+	if result != C.OCI_SUCCESS {
+		C.OCIDescriptorFree(*lobPP, C.OCI_DTYPE_LOB)
+		return nil, conn.getError(result)
+	}
+	lob := &Lob{conn: conn, locator: (*C.OCILobLocator)(*lobPP), isClob: isClob, form: C.SQLCS_IMPLICIT}
+
+	if isClob {
+		result = C.OCILobCharSetForm(
+			conn.env,       // environment handle
+			conn.errHandle, // error handle
+			lob.locator,    // LOB locator
+			&lob.form,      // character set form
+		)
+		if result != C.OCI_SUCCESS {
+			lob.Close()
+			return nil, conn.getError(result)
+		}
+	}
+
+	return lob, nil
+}
+
+// IsClob returns true if the Lob is a CLOB or NCLOB, false for a BLOB
+func (lob *Lob) IsClob() bool {
+	return lob.isClob
+}
+
+// IsNClob returns true if the Lob is an NCLOB
+func (lob *Lob) IsNClob() bool {
+	return lob.isClob && lob.form == C.SQLCS_NCHAR
+}
+
+// valid returns an error if the Lob has no locator
+func (lob *Lob) valid() error {
+	if lob.locator == nil {
+		return errors.New("lob has no locator")
+	}
+	return nil
+}
+
+// Read reads from the Lob at the current offset. For a CLOB p must be at least 4 bytes so a character fits.
+func (lob *Lob) Read(p []byte) (int, error) {
+	n, amount, err := lob.read(p, lob.offset)
+	lob.offset += amount
+	return n, err
+}
+
+// ReadAt reads len(p) bytes from the Lob at the offset, in characters for a CLOB.
+// For a CLOB fewer bytes may be read without an error, since the size of the characters is unknown.
+func (lob *Lob) ReadAt(p []byte, offset int64) (int, error) {
+	if offset < 0 {
+		return 0, errors.New("negative offset")
+	}
+	total := 0
+	for total < len(p) {
+		n, amount, err := lob.read(p[total:], offset)
+		total += n
+		offset += amount
+		if err == io.ErrShortBuffer && total > 0 {
+			return total, nil
+		}
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+// read calls OCILobRead2 once to read into p at the offset.
+// Returns the number of bytes read and the amount read, in characters for a CLOB.
+func (lob *Lob) read(p []byte, offset int64) (int, int64, error) {
+	err := lob.valid()
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(p) == 0 {
+		return 0, 0, nil
+	}
+
+	// a CLOB is read in characters so the buffer is never too small, up to 4 bytes for each character
+	byteAmount := C.oraub8(len(p))
+	charAmount := C.oraub8(0)
+	if lob.isClob {
+		byteAmount = 0
+		charAmount = C.oraub8(len(p) / 4)
+		if charAmount == 0 {
+			return 0, 0, io.ErrShortBuffer
+		}
+	}
+
+	result := C.OCILobRead2(
+		lob.conn.svc,          // service context handle
+		lob.conn.errHandle,    // error handle
+		lob.locator,           // LOB or BFILE locator
+		&byteAmount,           // IN - number of bytes to read, used for a BLOB. OUT - number of bytes read
+		&charAmount,           // IN - number of characters to read, used for a CLOB. OUT - number of characters read
+		C.oraub8(offset+1),    // the offset starting from 1, in bytes for a BLOB and characters for a CLOB
+		unsafe.Pointer(&p[0]), // pointer to a buffer into which the piece will be read
+		C.oraub8(len(p)),      // length of the buffer
+		C.OCI_ONE_PIECE,       // read in one piece
+		nil,                   // context pointer for the callback function
+		nil,                   // callback function
+		0,                     // character set ID of the buffer data, 0 is the client character set
+		lob.form,              // character set form of the buffer data
+	)
+	if result == C.OCI_NO_DATA {
+		return 0, 0, io.EOF
+	}
+	if result != C.OCI_SUCCESS {
+		return 0, 0, lob.conn.getError(result)
+	}
+	if byteAmount == 0 {
+		return 0, 0, io.EOF
+	}
+
+	if lob.isClob {
+		return int(byteAmount), int64(charAmount), nil
+	}
+	return int(byteAmount), int64(byteAmount), nil
+}
+
+// Write writes p to the Lob at the current offset. For a CLOB p must end with a whole character.
+func (lob *Lob) Write(p []byte) (int, error) {
+	amount, err := lob.write(p, lob.offset)
+	if err != nil {
+		return 0, err
+	}
+	lob.offset += amount
+	return len(p), nil
+}
+
+// WriteAt writes p to the Lob at the offset, in characters for a CLOB
+func (lob *Lob) WriteAt(p []byte, offset int64) (int, error) {
+	if offset < 0 {
+		return 0, errors.New("negative offset")
+	}
+	_, err := lob.write(p, offset)
+	if err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// write calls OCILobWrite2 to write p at the offset, returns the amount written, in characters for a CLOB
+func (lob *Lob) write(p []byte, offset int64) (int64, error) {
+	err := lob.valid()
+	if err != nil {
+		return 0, err
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	byteAmount := C.oraub8(len(p))
+	charAmount := C.oraub8(0)
+	result := C.OCILobWrite2(
+		lob.conn.svc,          // service context handle
+		lob.conn.errHandle,    // error handle
+		lob.locator,           // LOB locator
+		&byteAmount,           // IN - number of bytes to write. OUT - number of bytes written
+		&charAmount,           // IN - 0 so the number of bytes is used. OUT - number of characters written
+		C.oraub8(offset+1),    // the offset starting from 1, in bytes for a BLOB and characters for a CLOB
+		unsafe.Pointer(&p[0]), // pointer to a buffer from which the piece is written
+		C.oraub8(len(p)),      // length of the buffer
+		C.OCI_ONE_PIECE,       // write in one piece
+		nil,                   // context pointer for the callback function
+		nil,                   // callback function
+		0,                     // character set ID of the buffer data, 0 is the client character set
+		lob.form,              // character set form of the buffer data
+	)
+	if result != C.OCI_SUCCESS {
+		return 0, lob.conn.getError(result)
+	}
+
+	if lob.isClob {
+		return int64(charAmount), nil
+	}
+	return int64(byteAmount), nil
+}
+
+// ReadFrom writes the content of reader to the Lob at the current offset until EOF, in pieces of lobStreamSize bytes.
+// For a CLOB the pieces end with whole UTF-8 characters.
+func (lob *Lob) ReadFrom(reader io.Reader) (int64, error) {
+	buffer := make([]byte, lobStreamSize)
+	var total int64
+	var pending int // bytes of an incomplete character from the last read
+	for {
+		n, err := reader.Read(buffer[pending:])
+		n += pending
+		pending = 0
+		if err != nil && err != io.EOF {
+			return total, err
+		}
+
+		end := n
+		if lob.isClob && err == nil {
+			end = utf8End(buffer[:n])
+		}
+		if end > 0 {
+			_, writeErr := lob.Write(buffer[:end])
+			if writeErr != nil {
+				return total, writeErr
+			}
+			total += int64(end)
+		}
+		pending = copy(buffer, buffer[end:n])
+
+		if err == io.EOF {
+			return total, nil
+		}
+	}
+}
+
+// utf8End returns the length of p without an incomplete UTF-8 character at the end
+func utf8End(p []byte) int {
+	for i := len(p) - 1; i >= 0 && i >= len(p)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(p[i]) {
+			if utf8.FullRune(p[i:]) {
+				return len(p)
+			}
+			return i
+		}
+	}
+	return len(p)
+}
+
+// Seek sets the offset of the next Read or Write, in characters for a CLOB
+func (lob *Lob) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += lob.offset
+	case io.SeekEnd:
+		size, err := lob.Size()
+		if err != nil {
+			return lob.offset, err
+		}
+		offset += size
+	default:
+		return lob.offset, fmt.Errorf("invalid whence %v", whence)
+	}
+	if offset < 0 {
+		return lob.offset, errors.New("negative offset")
+	}
+	lob.offset = offset
+	return offset, nil
+}
+
+// Size returns the length of the Lob, in characters for a CLOB
+func (lob *Lob) Size() (int64, error) {
+	err := lob.valid()
+	if err != nil {
+		return 0, err
+	}
+	var length C.oraub8
+	result := C.OCILobGetLength2(
+		lob.conn.svc,       // service context handle
+		lob.conn.errHandle, // error handle
+		lob.locator,        // LOB locator
+		&length,            // length of the LOB
+	)
+	if result != C.OCI_SUCCESS {
+		return 0, lob.conn.getError(result)
+	}
+	return int64(length), nil
+}
+
+// Trim truncates the Lob to the size, in characters for a CLOB
+func (lob *Lob) Trim(size int64) error {
+	err := lob.valid()
+	if err != nil {
+		return err
+	}
+	if size < 0 {
+		return errors.New("negative size")
+	}
+	result := C.OCILobTrim2(
+		lob.conn.svc,       // service context handle
+		lob.conn.errHandle, // error handle
+		lob.locator,        // LOB locator
+		C.oraub8(size),     // new length of the LOB
+	)
+	return lob.conn.getError(result)
+}
+
+// ChunkSize returns the chunk size of the Lob. Reads and writes in multiples of the chunk size are the most efficient.
+func (lob *Lob) ChunkSize() (int, error) {
+	err := lob.valid()
+	if err != nil {
+		return 0, err
+	}
+	var chunkSize C.ub4
+	result := C.OCILobGetChunkSize(
+		lob.conn.svc,       // service context handle
+		lob.conn.errHandle, // error handle
+		lob.locator,        // LOB locator
+		&chunkSize,         // chunk size
+	)
+	if result != C.OCI_SUCCESS {
+		return 0, lob.conn.getError(result)
+	}
+	return int(chunkSize), nil
+}
+
+// Open opens the Lob read write until Close, so a batch of writes updates indexes and triggers once when the Lob is closed
+func (lob *Lob) Open() error {
+	err := lob.valid()
+	if err != nil {
+		return err
+	}
+	if lob.opened {
+		return nil
+	}
+	result := C.OCILobOpen(
+		lob.conn.svc,        // service context handle
+		lob.conn.errHandle,  // error handle
+		lob.locator,         // LOB locator
+		C.OCI_LOB_READWRITE, // open mode
+	)
+	err = lob.conn.getError(result)
+	if err != nil {
+		return err
+	}
+	lob.opened = true
+	return nil
+}
+
+// Close closes the Lob if it was opened with Open, frees a temporary LOB, and frees the locator
+func (lob *Lob) Close() error {
+	if lob.locator == nil {
+		return nil
+	}
+
+	var err error
+	if lob.opened {
+		result := C.OCILobClose(
+			lob.conn.svc,       // service context handle
+			lob.conn.errHandle, // error handle
+			lob.locator,        // LOB locator
+		)
+		err = lob.conn.getError(result)
+		lob.opened = false
+	}
+
+	var isTemporary C.boolean
+	result := C.OCILobIsTemporary(lob.conn.env, lob.conn.errHandle, lob.locator, &isTemporary)
+	if result == C.OCI_SUCCESS && isTemporary == C.TRUE {
+		result = C.OCILobFreeTemporary(lob.conn.svc, lob.conn.errHandle, lob.locator)
+		if err == nil {
+			err = lob.conn.getError(result)
+		}
+	}
+
+	C.OCIDescriptorFree(unsafe.Pointer(lob.locator), C.OCI_DTYPE_LOB)
+	lob.locator = nil
+	return err
+}
+
+// Scan implements sql.Scanner so a LOB column of a query with the LobLocator option can be scanned into a Lob.
+// The Lob must be closed.
+func (lob *Lob) Scan(src interface{}) error {
+	switch value := src.(type) {
+	case *Lob:
+		// the Lob takes the locator, so it stays valid after the rows are closed
+		*lob = *value
+		value.locator = nil
+		value.opened = false
+	case nil:
+		return errors.New("cannot scan NULL into Lob")
+	case []byte, string:
+		return errors.New("cannot scan LOB content into Lob, use the LobLocator option")
+	default:
+		return fmt.Errorf("cannot scan %T into Lob", src)
+	}
+	return nil
+}
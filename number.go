@@ -0,0 +1,225 @@
+package oci8
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// String returns the decimal string of the number
+func (number Number) String() string {
+	return string(number)
+}
+
+// Value implements driver.Valuer, the number is bound as an Oracle NUMBER without converting to float64
+func (number Number) Value() (driver.Value, error) {
+	return string(number), nil
+}
+
+// Scan implements sql.Scanner so any number column can be scanned into a Number
+func (number *Number) Scan(src interface{}) error {
+	switch value := src.(type) {
+	case Number:
+		*number = value
+	case string:
+		*number = Number(value)
+	case []byte:
+		*number = Number(value)
+	case int64:
+		*number = Number(strconv.FormatInt(value, 10))
+	case float64:
+		*number = Number(strconv.FormatFloat(value, 'f', -1, 64))
+	case nil:
+		return errors.New("cannot scan NULL into Number")
+	default:
+		return fmt.Errorf("cannot scan %T into Number", src)
+	}
+	return nil
+}
+
+// Rat returns the number as a big.Rat
+func (number Number) Rat() (*big.Rat, error) {
+	rat, ok := new(big.Rat).SetString(string(number))
+	if !ok {
+		return nil, fmt.Errorf("invalid number %q", string(number))
+	}
+	return rat, nil
+}
+
+// BigInt returns the number as a big.Int, returns an error if the number is not an integer
+func (number Number) BigInt() (*big.Int, error) {
+	rat, err := number.Rat()
+	if err != nil {
+		return nil, err
+	}
+	if !rat.IsInt() {
+		return nil, fmt.Errorf("number %v is not an integer", string(number))
+	}
+	return rat.Num(), nil
+}
+
+// Int64 returns the number as an int64, returns an error if the number is not an integer or does not fit in an int64
+func (number Number) Int64() (int64, error) {
+	bigInt, err := number.BigInt()
+	if err != nil {
+		return 0, err
+	}
+	if !bigInt.IsInt64() {
+		return 0, fmt.Errorf("number %v overflows int64", string(number))
+	}
+	return bigInt.Int64(), nil
+}
+
+// Float64 returns the nearest float64 of the number
+func (number Number) Float64() (float64, error) {
+	return strconv.ParseFloat(string(number), 64)
+}
+
+// decodeNumber converts an Oracle NUMBER in the internal format, without the length byte of SQLT_VNU, to a Number.
+// The first byte is the sign and the base 100 exponent, followed by the base 100 digits of the mantissa.
+func decodeNumber(buf []byte) (Number, error) {
+	if len(buf) == 0 {
+		return "", errors.New("empty number")
+	}
+	if buf[0] == 0x80 && len(buf) == 1 {
+		return "0", nil
+	}
+
+	negative := buf[0]&0x80 == 0
+	mantissa := buf[1:]
+	var exponent int
+	if negative {
+		exponent = int((^buf[0])&0x7F) - 65
+		if len(mantissa) > 0 && mantissa[len(mantissa)-1] == 102 {
+			mantissa = mantissa[:len(mantissa)-1]
+		}
+	} else {
+		exponent = int(buf[0]&0x7F) - 65
+	}
+	if len(mantissa) == 0 || len(mantissa) > 20 {
+		return "", errors.New("infinite or invalid number")
+	}
+
+	// two decimal digits for each base 100 digit
+	digits := make([]byte, 0, 2*len(mantissa))
+	for _, b := range mantissa {
+		digit := int(b) - 1
+		if negative {
+			digit = 101 - int(b)
+		}
+		if digit < 0 || digit > 99 {
+			return "", fmt.Errorf("invalid number digit %v", b)
+		}
+		digits = append(digits, byte('0'+digit/10), byte('0'+digit%10))
+	}
+
+	// decimal point is after the base 100 digit of exponent 0
+	point := 2 * (exponent + 1)
+	var integer, fraction string
+	switch {
+	case point <= 0:
+		integer = "0"
+		fraction = strings.Repeat("0", -point) + string(digits)
+	case point >= len(digits):
+		integer = string(digits) + strings.Repeat("0", point-len(digits))
+	default:
+		integer = string(digits[:point])
+		fraction = string(digits[point:])
+	}
+	integer = strings.TrimLeft(integer, "0")
+	if integer == "" {
+		integer = "0"
+	}
+	fraction = strings.TrimRight(fraction, "0")
+
+	number := integer
+	if fraction != "" {
+		number += "." + fraction
+	}
+	if negative {
+		number = "-" + number
+	}
+	return Number(number), nil
+}
+
+// encodeNumber converts a decimal string, optionally with an exponent like 1.5e-3, to an Oracle NUMBER in the internal format.
+// Returns an error if the number has more than 40 significant digits or the exponent is out of range.
+func encodeNumber(number string) ([]byte, error) {
+	s := strings.TrimSpace(number)
+	negative := false
+	if len(s) > 0 && (s[0] == '-' || s[0] == '+') {
+		negative = s[0] == '-'
+		s = s[1:]
+	}
+
+	exponent := 0
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		var err error
+		exponent, err = strconv.Atoi(s[i+1:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q", number)
+		}
+		s = s[:i]
+	}
+
+	// digits without the point, point is the number of integer digits
+	point := strings.IndexByte(s, '.')
+	if point >= 0 {
+		s = s[:point] + s[point+1:]
+	} else {
+		point = len(s)
+	}
+	if s == "" {
+		return nil, fmt.Errorf("invalid number %q", number)
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return nil, fmt.Errorf("invalid number %q", number)
+		}
+	}
+	point += exponent
+
+	trimmed := strings.TrimLeft(s, "0")
+	point -= len(s) - len(trimmed)
+	s = strings.TrimRight(trimmed, "0")
+	if s == "" {
+		return []byte{0x80}, nil
+	}
+
+	// align the point to a base 100 digit
+	if point%2 != 0 {
+		s = "0" + s
+		point++
+	}
+	if len(s)%2 != 0 {
+		s += "0"
+	}
+	if len(s) > 40 {
+		return nil, fmt.Errorf("number %q has more than 40 significant digits", number)
+	}
+	exponent = point/2 - 1
+	if exponent < -65 || exponent > 62 {
+		return nil, fmt.Errorf("number %q is out of range", number)
+	}
+
+	buf := make([]byte, 1, 1+len(s)/2+1)
+	buf[0] = byte(exponent + 193)
+	if negative {
+		buf[0] = byte(62 - exponent)
+	}
+	for i := 0; i < len(s); i += 2 {
+		digit := int(s[i]-'0')*10 + int(s[i+1]-'0')
+		if negative {
+			buf = append(buf, byte(101-digit))
+		} else {
+			buf = append(buf, byte(digit+1))
+		}
+	}
+	if negative && len(buf) < 21 {
+		buf = append(buf, 102)
+	}
+	return buf, nil
+}
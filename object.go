@@ -0,0 +1,1064 @@
+package oci8
+
+// #include "oci8.go.h"
+import "C"
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+	"unsafe"
+)
+
+// Scan implements sql.Scanner so object type columns can be scanned into an Object.
+// A null object sets Attributes to nil.
+func (object *Object) Scan(src interface{}) error {
+	switch value := src.(type) {
+	case nil:
+		object.Attributes = nil
+	case *Object:
+		*object = *value
+	default:
+		return fmt.Errorf("cannot scan %T into *Object", src)
+	}
+	return nil
+}
+
+// Scan implements sql.Scanner so collection type columns can be scanned into a Collection.
+// A null collection sets Elements to nil.
+func (collection *Collection) Scan(src interface{}) error {
+	switch value := src.(type) {
+	case nil:
+		collection.Elements = nil
+	case *Collection:
+		*collection = *value
+	default:
+		return fmt.Errorf("cannot scan %T into *Collection", src)
+	}
+	return nil
+}
+
+// splitTypeName splits an optionally schema qualified type name into the schema and type name.
+// Names that are not in double quotes are converted to upper case.
+func splitTypeName(typeName string) (string, string) {
+	var schemaName string
+	inQuotes := false
+	for i := 0; i < len(typeName); i++ {
+		if typeName[i] == '"' {
+			inQuotes = !inQuotes
+		} else if typeName[i] == '.' && !inQuotes {
+			schemaName = typeName[:i]
+			typeName = typeName[i+1:]
+			break
+		}
+	}
+
+	unquote := func(name string) string {
+		if len(name) > 1 && name[0] == '"' && name[len(name)-1] == '"' {
+			return name[1 : len(name)-1]
+		}
+		return strings.ToUpper(name)
+	}
+
+	if schemaName != "" {
+		schemaName = unquote(schemaName)
+	}
+	return schemaName, unquote(typeName)
+}
+
+// getObjectType returns the described object or collection type for the optionally schema qualified type name
+func (conn *Conn) getObjectType(typeName string) (*objectType, error) {
+	schemaName, name := splitTypeName(typeName)
+	return conn.getObjectTypeByName(schemaName, name)
+}
+
+// getObjectTypeByName returns the described object or collection type from the connection cache,
+// or calls OCITypeByName and describes the type. An empty schema name is the current schema.
+func (conn *Conn) getObjectTypeByName(schemaName string, name string) (*objectType, error) {
+	key := schemaName + "." + name
+	if objType, ok := conn.objectTypes[key]; ok {
+		return objType, nil
+	}
+
+	var schemaP *C.OraText
+	if schemaName != "" {
+		schemaP = cString(schemaName)
+		defer C.free(unsafe.Pointer(schemaP))
+	}
+	nameP := cString(name)
+	defer C.free(unsafe.Pointer(nameP))
+
+	var tdo *C.OCIType
+	result := C.OCITypeByName(
+		conn.env,               // environment handle
+		conn.errHandle,         // error handle
+		conn.svc,               // service context handle
+		schemaP,                // schema name, NULL for the current schema
+		C.ub4(len(schemaName)), // schema name length
+		nameP,                  // type name
+		C.ub4(len(name)),       // type name length
+		nil,                    // version name, ignored
+		0,                      // version name length
+		C.OCI_DURATION_SESSION, // pin duration
+		C.OCI_TYPEGET_ALL,      // get option: OCI_TYPEGET_ALL loads the type and its attributes
+		&tdo,                   // the type descriptor object
+	)
+	err := conn.getError(result)
+	if err != nil {
+		return nil, fmt.Errorf("type %v - error: %v", key, err)
+	}
+
+	objType, err := conn.describeObjectType(tdo)
+	if err != nil {
+		return nil, fmt.Errorf("describe type %v - error: %v", key, err)
+	}
+
+	if conn.objectTypes == nil {
+		conn.objectTypes = make(map[string]*objectType)
+	}
+	conn.objectTypes[key] = objType
+	conn.objectTypes[objType.name] = objType
+
+	return objType, nil
+}
+
+// describeObjectType calls OCIDescribeAny on the type descriptor object, then returns the described type
+func (conn *Conn) describeObjectType(tdo *C.OCIType) (*objectType, error) {
+	describeP, _, err := conn.ociHandleAlloc(C.OCI_HTYPE_DESCRIBE, 0)
+	if err != nil {
+		return nil, err
+	}
+	describe := (*C.OCIDescribe)(*describeP)
+	defer C.OCIHandleFree(unsafe.Pointer(describe), C.OCI_HTYPE_DESCRIBE)
+
+	result := C.OCIDescribeAny(
+		conn.svc,            // service context handle
+		conn.errHandle,      // error handle
+		unsafe.Pointer(tdo), // the object to describe
+		0,                   // length of the object name, 0 for a pointer
+		C.OCI_OTYPE_PTR,     // the object is a pointer to a type descriptor object
+		C.OCI_DEFAULT,       // info level
+		C.OCI_PTYPE_TYPE,    // the object is a type
+		describe,            // describe handle
+	)
+	err = conn.getError(result)
+	if err != nil {
+		return nil, err
+	}
+
+	var param *C.OCIParam
+	result = C.OCIAttrGet(
+		unsafe.Pointer(describe), // describe handle
+		C.OCI_HTYPE_DESCRIBE,     // handle type
+		unsafe.Pointer(&param),   // the parameter descriptor of the type, freed with the describe handle
+		nil,                      // size
+		C.OCI_ATTR_PARAM,         // attribute type
+		conn.errHandle,           // error handle
+	)
+	err = conn.getError(result)
+	if err != nil {
+		return nil, err
+	}
+
+	objType := &objectType{conn: conn, tdo: tdo}
+
+	var name string
+	name, err = conn.ociAttrGetString(param, C.OCI_ATTR_NAME)
+	if err != nil {
+		return nil, err
+	}
+	var schemaName string
+	schemaName, err = conn.ociAttrGetString(param, C.OCI_ATTR_SCHEMA_NAME)
+	if err != nil {
+		return nil, err
+	}
+	objType.name = schemaName + "." + name
+	objType.typeName = name
+
+	_, err = conn.ociAttrGet(param, unsafe.Pointer(&objType.typeCode), C.OCI_ATTR_TYPECODE)
+	if err != nil {
+		return nil, err
+	}
+
+	switch objType.typeCode {
+	case C.OCI_TYPECODE_OBJECT:
+		var numAttributes C.ub2
+		_, err = conn.ociAttrGet(param, unsafe.Pointer(&numAttributes), C.OCI_ATTR_NUM_TYPE_ATTRS)
+		if err != nil {
+			return nil, err
+		}
+		var listParam *C.OCIParam
+		_, err = conn.ociAttrGet(param, unsafe.Pointer(&listParam), C.OCI_ATTR_LIST_TYPE_ATTRS)
+		if err != nil {
+			return nil, err
+		}
+
+		objType.attributes = make([]objectAttribute, numAttributes)
+		for i := 0; i < int(numAttributes); i++ {
+			var attributeParam *C.OCIParam
+			result = C.OCIParamGet(
+				unsafe.Pointer(listParam), // list of attributes
+				C.OCI_DTYPE_PARAM,         // handle type
+				conn.errHandle,            // error handle
+				(*unsafe.Pointer)(unsafe.Pointer(&attributeParam)), // the parameter descriptor of the attribute
+				C.ub4(i+1), // position of the attribute
+			)
+			err = conn.getError(result)
+			if err != nil {
+				return nil, err
+			}
+
+			var attribute *objectAttribute
+			attribute, err = conn.describeObjectAttribute(attributeParam)
+			if err != nil {
+				return nil, err
+			}
+			attribute.name, err = conn.ociAttrGetString(attributeParam, C.OCI_ATTR_NAME)
+			if err != nil {
+				return nil, err
+			}
+			attribute.cName = cString(attribute.name)
+			objType.attributes[i] = *attribute
+		}
+
+	case C.OCI_TYPECODE_NAMEDCOLLECTION:
+		_, err = conn.ociAttrGet(param, unsafe.Pointer(&objType.collectionTypeCode), C.OCI_ATTR_COLLECTION_TYPECODE)
+		if err != nil {
+			return nil, err
+		}
+		var elementParam *C.OCIParam
+		_, err = conn.ociAttrGet(param, unsafe.Pointer(&elementParam), C.OCI_ATTR_COLLECTION_ELEMENT)
+		if err != nil {
+			return nil, err
+		}
+		objType.element, err = conn.describeObjectAttribute(elementParam)
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported type code %v for type %v", objType.typeCode, objType.name)
+	}
+
+	return objType, nil
+}
+
+// describeObjectAttribute returns the described attribute or collection element from the parameter descriptor
+func (conn *Conn) describeObjectAttribute(param *C.OCIParam) (*objectAttribute, error) {
+	attribute := &objectAttribute{}
+
+	_, err := conn.ociAttrGet(param, unsafe.Pointer(&attribute.typeCode), C.OCI_ATTR_TYPECODE)
+	if err != nil {
+		return nil, err
+	}
+
+	switch attribute.typeCode {
+	case C.OCI_TYPECODE_NUMBER, C.OCI_TYPECODE_DECIMAL:
+		var precision C.ub1
+		_, err = conn.ociAttrGet(param, unsafe.Pointer(&precision), C.OCI_ATTR_PRECISION)
+		if err != nil {
+			return nil, err
+		}
+		var scale C.sb1
+		_, err = conn.ociAttrGet(param, unsafe.Pointer(&scale), C.OCI_ATTR_SCALE)
+		if err != nil {
+			return nil, err
+		}
+		// same as column defines, NUMBER(precision, 0) is an integer and everything else is a float
+		attribute.isInteger = precision != 0 && scale == 0
+
+	case C.OCI_TYPECODE_INTEGER, C.OCI_TYPECODE_SMALLINT, C.OCI_TYPECODE_OCTET:
+		attribute.isInteger = true
+
+	case C.OCI_TYPECODE_OBJECT, C.OCI_TYPECODE_NAMEDCOLLECTION:
+		var typeName string
+		typeName, err = conn.ociAttrGetString(param, C.OCI_ATTR_TYPE_NAME)
+		if err != nil {
+			return nil, err
+		}
+		var schemaName string
+		schemaName, err = conn.ociAttrGetString(param, C.OCI_ATTR_SCHEMA_NAME)
+		if err != nil {
+			return nil, err
+		}
+		attribute.objectType, err = conn.getObjectTypeByName(schemaName, typeName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return attribute, nil
+}
+
+// ociAttrGetString calls OCIAttrGet with OCIParam for a text attribute then returns the text as a string
+func (conn *Conn) ociAttrGetString(param *C.OCIParam, attributeType C.ub4) (string, error) {
+	var text *C.OraText
+	size, err := conn.ociAttrGet(param, unsafe.Pointer(&text), attributeType)
+	if err != nil {
+		return "", err
+	}
+	return cGoStringN(text, int(size)), nil
+}
+
+// freeObjectTypes frees the C memory of the cached object types
+func (conn *Conn) freeObjectTypes() {
+	freed := make(map[*objectType]bool, len(conn.objectTypes))
+	for _, objType := range conn.objectTypes {
+		if freed[objType] {
+			continue
+		}
+		freed[objType] = true
+		for i := 0; i < len(objType.attributes); i++ {
+			C.free(unsafe.Pointer(objType.attributes[i].cName))
+			objType.attributes[i].cName = nil
+		}
+	}
+	conn.objectTypes = nil
+}
+
+// freeObject frees the object instance that the C memory instance pointer points to, then frees the C memory pointers
+func freeObject(objType *objectType, instanceP unsafe.Pointer, indicatorP unsafe.Pointer) {
+	if instanceP != nil {
+		instance := *(*unsafe.Pointer)(instanceP)
+		if instance != nil && objType.conn.env != nil {
+			C.OCIObjectFree(objType.conn.env, objType.conn.errHandle, instance, C.OCI_OBJECTFREE_FORCE)
+		}
+		C.free(instanceP)
+	}
+	if indicatorP != nil {
+		C.free(indicatorP)
+	}
+}
+
+// objectToGo converts an object or collection instance to an *Object or *Collection.
+// Returns nil if the instance is null.
+func (conn *Conn) objectToGo(objType *objectType, instance unsafe.Pointer, nullStruct unsafe.Pointer) (interface{}, error) {
+	if instance == nil || (nullStruct != nil && *(*C.OCIInd)(nullStruct) == C.OCI_IND_NULL) {
+		return nil, nil
+	}
+
+	if objType.typeCode == C.OCI_TYPECODE_NAMEDCOLLECTION {
+		return conn.collectionToGo(objType, (*C.OCIColl)(instance))
+	}
+
+	if nullStruct == nil {
+		result := C.OCIObjectGetInd(conn.env, conn.errHandle, instance, &nullStruct)
+		err := conn.getError(result)
+		if err != nil {
+			return nil, err
+		}
+		if *(*C.OCIInd)(nullStruct) == C.OCI_IND_NULL {
+			return nil, nil
+		}
+	}
+
+	object := &Object{TypeName: objType.name, Attributes: make(map[string]interface{}, len(objType.attributes))}
+	for i := 0; i < len(objType.attributes); i++ {
+		attribute := &objType.attributes[i]
+		name := attribute.cName
+		nameLength := C.ub4(len(attribute.name))
+		var nullStatus C.OCIInd
+		var attributeNullStruct unsafe.Pointer
+		var attributeValue unsafe.Pointer
+		var attributeTdo *C.OCIType
+
+		result := C.OCIObjectGetAttr(
+			conn.env,             // environment handle
+			conn.errHandle,       // error handle
+			instance,             // the object instance
+			nullStruct,           // the null structure of the object instance
+			objType.tdo,          // the type descriptor object of the object instance
+			&name,                // the attribute name
+			&nameLength,          // the attribute name length
+			1,                    // number of names
+			nil,                  // array indexes, not supported
+			0,                    // number of array indexes
+			&nullStatus,          // the null status of the attribute
+			&attributeNullStruct, // the null structure of the attribute if it is an object
+			&attributeValue,      // pointer to the attribute value
+			&attributeTdo,        // the type descriptor object of the attribute
+		)
+		err := conn.getError(result)
+		if err != nil {
+			return nil, fmt.Errorf("attribute %v - error: %v", attribute.name, err)
+		}
+
+		indicator := unsafe.Pointer(&nullStatus)
+		if attribute.typeCode == C.OCI_TYPECODE_OBJECT {
+			indicator = attributeNullStruct
+		}
+		object.Attributes[attribute.name], err = conn.objectValueToGo(attribute, attributeValue, indicator)
+		if err != nil {
+			return nil, fmt.Errorf("attribute %v - error: %v", attribute.name, err)
+		}
+	}
+
+	return object, nil
+}
+
+// collectionToGo converts a collection instance to a *Collection
+func (conn *Conn) collectionToGo(objType *objectType, collection *C.OCIColl) (*Collection, error) {
+	var size C.sb4
+	result := C.OCICollSize(conn.env, conn.errHandle, collection, &size)
+	err := conn.getError(result)
+	if err != nil {
+		return nil, err
+	}
+
+	goCollection := &Collection{TypeName: objType.name, Elements: make([]interface{}, 0, size)}
+	for i := C.sb4(0); i < size; i++ {
+		var exists C.boolean
+		var element unsafe.Pointer
+		var elementIndicator unsafe.Pointer
+		result = C.OCICollGetElem(conn.env, conn.errHandle, collection, i, &exists, &element, &elementIndicator)
+		err = conn.getError(result)
+		if err != nil {
+			return nil, fmt.Errorf("element %v - error: %v", i, err)
+		}
+		if exists == 0 {
+			// deleted nested table element
+			continue
+		}
+
+		var value interface{}
+		value, err = conn.objectValueToGo(objType.element, element, elementIndicator)
+		if err != nil {
+			return nil, fmt.Errorf("element %v - error: %v", i, err)
+		}
+		goCollection.Elements = append(goCollection.Elements, value)
+	}
+
+	return goCollection, nil
+}
+
+// objectValueToGo converts an attribute or collection element value to a Go value.
+// The value points to the attribute storage as returned by OCIObjectGetAttr and OCICollGetElem.
+func (conn *Conn) objectValueToGo(attribute *objectAttribute, value unsafe.Pointer, indicator unsafe.Pointer) (interface{}, error) {
+	if value == nil || (indicator != nil && *(*C.OCIInd)(indicator) == C.OCI_IND_NULL) {
+		return nil, nil
+	}
+
+	switch attribute.typeCode {
+
+	case C.OCI_TYPECODE_VARCHAR2, C.OCI_TYPECODE_VARCHAR, C.OCI_TYPECODE_CHAR, C.OCI_TYPECODE_NCHAR, C.OCI_TYPECODE_NVARCHAR2:
+		ociString := *(**C.OCIString)(value)
+		return C.GoStringN((*C.char)(unsafe.Pointer(C.OCIStringPtr(conn.env, ociString))), C.int(C.OCIStringSize(conn.env, ociString))), nil
+
+	case C.OCI_TYPECODE_NUMBER, C.OCI_TYPECODE_DECIMAL, C.OCI_TYPECODE_INTEGER, C.OCI_TYPECODE_SMALLINT, C.OCI_TYPECODE_OCTET,
+		C.OCI_TYPECODE_FLOAT, C.OCI_TYPECODE_REAL, C.OCI_TYPECODE_DOUBLE:
+		number := (*C.OCINumber)(value)
+		if attribute.isInteger {
+			var integer int64
+			result := C.OCINumberToInt(conn.errHandle, number, 8, C.OCI_NUMBER_SIGNED, unsafe.Pointer(&integer))
+			return integer, conn.getError(result)
+		}
+		var float float64
+		result := C.OCINumberToReal(conn.errHandle, number, 8, unsafe.Pointer(&float))
+		return float, conn.getError(result)
+
+	case C.OCI_TYPECODE_BDOUBLE:
+		return float64(*(*C.double)(value)), nil
+
+	case C.OCI_TYPECODE_BFLOAT:
+		return float64(*(*C.float)(value)), nil
+
+	case C.OCI_TYPECODE_DATE:
+		return conn.ociDateToTime((*C.OCIDate)(value)), nil
+
+	case C.OCI_TYPECODE_TIMESTAMP, C.OCI_TYPECODE_TIMESTAMP_TZ, C.OCI_TYPECODE_TIMESTAMP_LTZ:
+		aTime, err := conn.ociDateTimeToTime(*(**C.OCIDateTime)(value), attribute.typeCode != C.OCI_TYPECODE_TIMESTAMP)
+		if err != nil {
+			return nil, err
+		}
+		return *aTime, nil
+
+	case C.OCI_TYPECODE_RAW:
+		raw := *(**C.OCIRaw)(value)
+		return C.GoBytes(unsafe.Pointer(C.OCIRawPtr(conn.env, raw)), C.int(C.OCIRawSize(conn.env, raw))), nil
+
+	case C.OCI_TYPECODE_OBJECT:
+		return conn.objectToGo(attribute.objectType, value, indicator)
+
+	case C.OCI_TYPECODE_NAMEDCOLLECTION:
+		return conn.objectToGo(attribute.objectType, *(*unsafe.Pointer)(value), indicator)
+
+	}
+
+	return nil, fmt.Errorf("unsupported type code %v", attribute.typeCode)
+}
+
+// goToObject creates a new object or collection instance from an Object, Collection, or slice value.
+// A nil value creates a null object or an empty collection.
+// The returned instance must be freed with OCIObjectFree.
+func (conn *Conn) goToObject(objType *objectType, value interface{}) (unsafe.Pointer, error) {
+	typeCode := C.OCITypeCode(C.OCI_TYPECODE_OBJECT)
+	if objType.typeCode == C.OCI_TYPECODE_NAMEDCOLLECTION {
+		typeCode = objType.collectionTypeCode
+	}
+
+	var instance unsafe.Pointer
+	result := C.OCIObjectNew(
+		conn.env,               // environment handle
+		conn.errHandle,         // error handle
+		conn.svc,               // service context handle
+		typeCode,               // type code of the instance
+		objType.tdo,            // the type descriptor object
+		nil,                    // table, NULL for a transient instance
+		C.OCI_DURATION_SESSION, // duration
+		1,                      // value: TRUE creates a value instead of a referenceable object
+		&instance,              // the new instance
+	)
+	err := conn.getError(result)
+	if err != nil {
+		return nil, err
+	}
+
+	if objType.typeCode == C.OCI_TYPECODE_NAMEDCOLLECTION {
+		err = conn.setCollectionElements(objType, (*C.OCIColl)(instance), value)
+	} else {
+		err = conn.setObjectAttributes(objType, instance, value)
+	}
+	if err != nil {
+		C.OCIObjectFree(conn.env, conn.errHandle, instance, C.OCI_OBJECTFREE_FORCE)
+		return nil, err
+	}
+
+	return instance, nil
+}
+
+// setObjectAttributes sets the attributes of the object instance from an *Object or Object.
+// Attributes missing from the Object are set to null. A nil value sets the object to null.
+func (conn *Conn) setObjectAttributes(objType *objectType, instance unsafe.Pointer, value interface{}) error {
+	var nullStruct unsafe.Pointer
+	result := C.OCIObjectGetInd(conn.env, conn.errHandle, instance, &nullStruct)
+	err := conn.getError(result)
+	if err != nil {
+		return err
+	}
+
+	var attributes map[string]interface{}
+	switch object := value.(type) {
+	case nil:
+	case *Object:
+		if object != nil {
+			attributes = object.Attributes
+		}
+	case Object:
+		attributes = object.Attributes
+	default:
+		attributes, err = structAttributes(value)
+		if err != nil {
+			return fmt.Errorf("cannot convert %T to object type %v", value, objType.name)
+		}
+	}
+	if attributes == nil {
+		*(*C.OCIInd)(nullStruct) = C.OCI_IND_NULL
+		return nil
+	}
+
+	found := 0
+	for i := 0; i < len(objType.attributes); i++ {
+		attribute := &objType.attributes[i]
+		attributeValue, ok := attributes[attribute.name]
+		if !ok {
+			for key := range attributes {
+				if strings.EqualFold(key, attribute.name) {
+					attributeValue, ok = attributes[key], true
+					break
+				}
+			}
+		}
+		if ok {
+			found++
+		}
+
+		err = conn.setObjectAttribute(objType, instance, nullStruct, attribute, attributeValue)
+		if err != nil {
+			return fmt.Errorf("attribute %v - error: %v", attribute.name, err)
+		}
+	}
+
+	if found < len(attributes) {
+		for key := range attributes {
+			if objType.attributeIndex(key) < 0 {
+				return fmt.Errorf("attribute %v not found in type %v", key, objType.name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// attributeIndex returns the index of the attribute name, or -1 if not found
+func (objType *objectType) attributeIndex(name string) int {
+	for i := 0; i < len(objType.attributes); i++ {
+		if strings.EqualFold(objType.attributes[i].name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// setObjectAttribute calls OCIObjectSetAttr to set the attribute of the object instance to the value
+func (conn *Conn) setObjectAttribute(objType *objectType, instance unsafe.Pointer, nullStruct unsafe.Pointer, attribute *objectAttribute, value interface{}) error {
+	attributeValue, nullStatus, attributeNullStruct, free, err := conn.goToObjectValue(attribute, value)
+	if err != nil {
+		return err
+	}
+	defer free()
+
+	name := attribute.cName
+	nameLength := C.ub4(len(attribute.name))
+	result := C.OCIObjectSetAttr(
+		conn.env,            // environment handle
+		conn.errHandle,      // error handle
+		instance,            // the object instance
+		nullStruct,          // the null structure of the object instance
+		objType.tdo,         // the type descriptor object of the object instance
+		&name,               // the attribute name
+		&nameLength,         // the attribute name length
+		1,                   // number of names
+		nil,                 // array indexes, not supported
+		0,                   // number of array indexes
+		nullStatus,          // the null status of the attribute
+		attributeNullStruct, // the null structure of the attribute if it is an object
+		attributeValue,      // pointer to the attribute value, the value is copied
+	)
+	return conn.getError(result)
+}
+
+// setCollectionElements appends the elements of a *Collection, Collection, or slice value to the collection instance
+func (conn *Conn) setCollectionElements(objType *objectType, collection *C.OCIColl, value interface{}) error {
+	var elements []interface{}
+	switch goCollection := value.(type) {
+	case nil:
+	case *Collection:
+		if goCollection != nil {
+			elements = goCollection.Elements
+		}
+	case Collection:
+		elements = goCollection.Elements
+	case []interface{}:
+		elements = goCollection
+	default:
+		slice := reflect.ValueOf(value)
+		if slice.Kind() == reflect.Ptr && !slice.IsNil() {
+			slice = slice.Elem()
+		}
+		if slice.Kind() != reflect.Slice || slice.Type().Elem().Kind() == reflect.Uint8 {
+			return fmt.Errorf("cannot convert %T to collection type %v", value, objType.name)
+		}
+		elements = make([]interface{}, slice.Len())
+		for i := 0; i < len(elements); i++ {
+			elements[i] = slice.Index(i).Interface()
+		}
+	}
+
+	indicator := (*C.OCIInd)(C.malloc(C.sizeof_OCIInd))
+	defer C.free(unsafe.Pointer(indicator))
+
+	for i := 0; i < len(elements); i++ {
+		element, nullStatus, elementNullStruct, free, err := conn.goToObjectValue(objType.element, elements[i])
+		if err != nil {
+			return fmt.Errorf("element %v - error: %v", i, err)
+		}
+
+		elementIndicator := elementNullStruct
+		if elementIndicator == nil {
+			*indicator = nullStatus
+			elementIndicator = unsafe.Pointer(indicator)
+		}
+
+		result := C.OCICollAppend(conn.env, conn.errHandle, element, elementIndicator, collection)
+		free()
+		err = conn.getError(result)
+		if err != nil {
+			return fmt.Errorf("element %v - error: %v", i, err)
+		}
+	}
+
+	return nil
+}
+
+// goToObjectValue converts a Go value to an attribute or collection element value as used by OCIObjectSetAttr and OCICollAppend.
+// Returns the value pointer, the null status, the null structure for object values, and a function that frees the value.
+func (conn *Conn) goToObjectValue(attribute *objectAttribute, value interface{}) (unsafe.Pointer, C.OCIInd, unsafe.Pointer, func(), error) {
+	noFree := func() {}
+
+	if isNilValue(value) {
+		value = nil
+	}
+
+	if attribute.objectType != nil {
+		instance, err := conn.goToObject(attribute.objectType, value)
+		if err != nil {
+			return nil, 0, nil, noFree, err
+		}
+		free := func() { C.OCIObjectFree(conn.env, conn.errHandle, instance, C.OCI_OBJECTFREE_FORCE) }
+
+		if attribute.typeCode == C.OCI_TYPECODE_NAMEDCOLLECTION {
+			if value == nil {
+				return instance, C.OCI_IND_NULL, nil, free, nil
+			}
+			return instance, C.OCI_IND_NOTNULL, nil, free, nil
+		}
+
+		var nullStruct unsafe.Pointer
+		result := C.OCIObjectGetInd(conn.env, conn.errHandle, instance, &nullStruct)
+		err = conn.getError(result)
+		if err != nil {
+			free()
+			return nil, 0, nil, noFree, err
+		}
+		return instance, *(*C.OCIInd)(nullStruct), nullStruct, free, nil
+	}
+
+	value, err := driver.DefaultParameterConverter.ConvertValue(value)
+	if err != nil {
+		return nil, 0, nil, noFree, err
+	}
+
+	nullStatus := C.OCIInd(C.OCI_IND_NOTNULL)
+	if value == nil {
+		// a value of the attribute type is still needed for OCICollAppend
+		nullStatus = C.OCI_IND_NULL
+		switch attribute.typeCode {
+		case C.OCI_TYPECODE_VARCHAR2, C.OCI_TYPECODE_VARCHAR, C.OCI_TYPECODE_CHAR, C.OCI_TYPECODE_NCHAR, C.OCI_TYPECODE_NVARCHAR2:
+			value = ""
+		case C.OCI_TYPECODE_DATE, C.OCI_TYPECODE_TIMESTAMP, C.OCI_TYPECODE_TIMESTAMP_TZ, C.OCI_TYPECODE_TIMESTAMP_LTZ:
+			value = time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)
+		case C.OCI_TYPECODE_RAW:
+			value = []byte{}
+		default:
+			value = int64(0)
+		}
+	}
+
+	switch attribute.typeCode {
+
+	case C.OCI_TYPECODE_VARCHAR2, C.OCI_TYPECODE_VARCHAR, C.OCI_TYPECODE_CHAR, C.OCI_TYPECODE_NCHAR, C.OCI_TYPECODE_NVARCHAR2:
+		var text string
+		switch goValue := value.(type) {
+		case string:
+			text = goValue
+		case []byte:
+			text = string(goValue)
+		default:
+			text = fmt.Sprintf("%v", goValue)
+		}
+		var ociString *C.OCIString
+		textP := cString(text)
+		result := C.OCIStringAssignText(conn.env, conn.errHandle, textP, C.ub4(len(text)), &ociString)
+		C.free(unsafe.Pointer(textP))
+		err = conn.getError(result)
+		if err != nil {
+			return nil, 0, nil, noFree, err
+		}
+		return unsafe.Pointer(ociString), nullStatus, nil, func() { C.OCIStringResize(conn.env, conn.errHandle, 0, &ociString) }, nil
+
+	case C.OCI_TYPECODE_NUMBER, C.OCI_TYPECODE_DECIMAL, C.OCI_TYPECODE_INTEGER, C.OCI_TYPECODE_SMALLINT, C.OCI_TYPECODE_OCTET,
+		C.OCI_TYPECODE_FLOAT, C.OCI_TYPECODE_REAL, C.OCI_TYPECODE_DOUBLE:
+		number := (*C.OCINumber)(C.malloc(C.sizeof_OCINumber))
+		free := func() { C.free(unsafe.Pointer(number)) }
+		var result C.sword
+		switch goValue := value.(type) {
+		case int64:
+			result = C.OCINumberFromInt(conn.errHandle, unsafe.Pointer(&goValue), 8, C.OCI_NUMBER_SIGNED, number)
+		case float64:
+			result = C.OCINumberFromReal(conn.errHandle, unsafe.Pointer(&goValue), 8, number)
+		case bool:
+			var integer int64
+			if goValue {
+				integer = 1
+			}
+			result = C.OCINumberFromInt(conn.errHandle, unsafe.Pointer(&integer), 8, C.OCI_NUMBER_SIGNED, number)
+		default:
+			free()
+			return nil, 0, nil, noFree, fmt.Errorf("cannot convert %T to number", value)
+		}
+		err = conn.getError(result)
+		if err != nil {
+			free()
+			return nil, 0, nil, noFree, err
+		}
+		return unsafe.Pointer(number), nullStatus, nil, free, nil
+
+	case C.OCI_TYPECODE_BDOUBLE, C.OCI_TYPECODE_BFLOAT:
+		var float float64
+		switch goValue := value.(type) {
+		case int64:
+			float = float64(goValue)
+		case float64:
+			float = goValue
+		default:
+			return nil, 0, nil, noFree, fmt.Errorf("cannot convert %T to float", value)
+		}
+		if attribute.typeCode == C.OCI_TYPECODE_BFLOAT {
+			floatP := (*C.float)(C.malloc(C.sizeof_float))
+			*floatP = C.float(float)
+			return unsafe.Pointer(floatP), nullStatus, nil, func() { C.free(unsafe.Pointer(floatP)) }, nil
+		}
+		doubleP := (*C.double)(C.malloc(C.sizeof_double))
+		*doubleP = C.double(float)
+		return unsafe.Pointer(doubleP), nullStatus, nil, func() { C.free(unsafe.Pointer(doubleP)) }, nil
+
+	case C.OCI_TYPECODE_DATE:
+		aTime, ok := value.(time.Time)
+		if !ok {
+			return nil, 0, nil, noFree, fmt.Errorf("cannot convert %T to date", value)
+		}
+		date := conn.timeToOCIDate(aTime)
+		return unsafe.Pointer(date), nullStatus, nil, func() { C.free(unsafe.Pointer(date)) }, nil
+
+	case C.OCI_TYPECODE_TIMESTAMP, C.OCI_TYPECODE_TIMESTAMP_TZ, C.OCI_TYPECODE_TIMESTAMP_LTZ:
+		aTime, ok := value.(time.Time)
+		if !ok {
+			return nil, 0, nil, noFree, fmt.Errorf("cannot convert %T to timestamp", value)
+		}
+		descriptorType := C.ub4(C.OCI_DTYPE_TIMESTAMP_TZ)
+		switch attribute.typeCode {
+		case C.OCI_TYPECODE_TIMESTAMP:
+			descriptorType = C.OCI_DTYPE_TIMESTAMP
+			aTime = aTime.In(conn.timeLocation)
+		case C.OCI_TYPECODE_TIMESTAMP_LTZ:
+			descriptorType = C.OCI_DTYPE_TIMESTAMP_LTZ
+		}
+		dateTimePP, err := conn.timeToOCIDateTimeType(&aTime, descriptorType)
+		if err != nil {
+			return nil, 0, nil, noFree, err
+		}
+		dateTime := *dateTimePP
+		return dateTime, nullStatus, nil, func() { C.OCIDescriptorFree(dateTime, descriptorType) }, nil
+
+	case C.OCI_TYPECODE_RAW:
+		var bytes []byte
+		switch goValue := value.(type) {
+		case []byte:
+			bytes = goValue
+		case string:
+			bytes = []byte(goValue)
+		default:
+			return nil, 0, nil, noFree, fmt.Errorf("cannot convert %T to raw", value)
+		}
+		var raw *C.OCIRaw
+		bytesP := cByte(bytes)
+		result := C.OCIRawAssignBytes(conn.env, conn.errHandle, (*C.ub1)(unsafe.Pointer(bytesP)), C.ub4(len(bytes)), &raw)
+		C.free(unsafe.Pointer(bytesP))
+		err = conn.getError(result)
+		if err != nil {
+			return nil, 0, nil, noFree, err
+		}
+		return unsafe.Pointer(raw), nullStatus, nil, func() { C.OCIRawResize(conn.env, conn.errHandle, 0, &raw) }, nil
+
+	}
+
+	return nil, 0, nil, noFree, fmt.Errorf("unsupported type code %v", attribute.typeCode)
+}
+
+// makeObjectBind fills sbind with an object or collection instance if the value is an Object or Collection,
+// or a sql.Out with an *Object or *Collection as Dest. The type name of the Object or Collection selects the Oracle type.
+// Returns false if the value is not bound as an object.
+func (stmt *Stmt) makeObjectBind(sbind *bindStruct, value interface{}) (bool, error) {
+	out, isOut := value.(sql.Out)
+	if isOut {
+		value = out.Dest
+	}
+
+	var typeName string
+	var isCollection bool
+	switch goValue := value.(type) {
+	case *Object:
+		if goValue == nil {
+			return false, nil
+		}
+		typeName = goValue.TypeName
+	case Object:
+		typeName = goValue.TypeName
+	case *Collection:
+		if goValue == nil {
+			return false, nil
+		}
+		typeName = goValue.TypeName
+		isCollection = true
+	case Collection:
+		typeName = goValue.TypeName
+		isCollection = true
+	default:
+		// registered struct and slice types, or a pointer to one
+		goType := reflect.TypeOf(value)
+		if goType != nil && goType.Kind() == reflect.Ptr {
+			goType = goType.Elem()
+		}
+		var ok bool
+		typeName, ok = registeredObjectTypeName(goType)
+		if !ok {
+			return false, nil
+		}
+		isCollection = goType.Kind() == reflect.Slice
+	}
+
+	if typeName == "" {
+		return false, errors.New("type name is empty")
+	}
+	objType, err := stmt.conn.getObjectType(typeName)
+	if err != nil {
+		return false, err
+	}
+	if isCollection != (objType.typeCode == C.OCI_TYPECODE_NAMEDCOLLECTION) {
+		return false, fmt.Errorf("cannot bind %T to type %v", value, objType.name)
+	}
+
+	if isOut && !out.In {
+		value = nil
+	}
+	instance, err := stmt.conn.goToObject(objType, value)
+	if err != nil {
+		return false, err
+	}
+
+	C.free(unsafe.Pointer(sbind.length))
+	C.free(unsafe.Pointer(sbind.indicator))
+	sbind.length = nil
+	sbind.indicator = nil
+	sbind.dataType = C.SQLT_NTY
+	sbind.out = out
+	sbind.objectType = objType
+	sbind.objectInstance = C.malloc(C.size_t(sizeOfNilPointer))
+	*(*unsafe.Pointer)(sbind.objectInstance) = instance
+
+	if !isCollection {
+		var nullStruct unsafe.Pointer
+		result := C.OCIObjectGetInd(stmt.conn.env, stmt.conn.errHandle, instance, &nullStruct)
+		err = stmt.conn.getError(result)
+		if err != nil {
+			return false, err
+		}
+		sbind.objectIndicator = C.malloc(C.size_t(sizeOfNilPointer))
+		*(*unsafe.Pointer)(sbind.objectIndicator) = nullStruct
+	}
+
+	return true, nil
+}
+
+// ociBindObject calls OCIBindObject to set the object instance and null structure of an object bind
+func (stmt *Stmt) ociBindObject(bind *bindStruct) error {
+	result := C.OCIBindObject(
+		bind.bindHandle,                         // the bind handle
+		stmt.conn.errHandle,                     // error handle
+		bind.objectType.tdo,                     // the type descriptor object of the bind
+		(*unsafe.Pointer)(bind.objectInstance),  // pointer to the pointer of the object instance
+		nil,                                     // size of the object instance, not needed
+		(*unsafe.Pointer)(bind.objectIndicator), // pointer to the pointer of the null structure, can be NULL
+		nil,                                     // size of the null structure, not needed
+	)
+	return stmt.conn.getError(result)
+}
+
+// outputObjectBind sets the out *Object or *Collection to the returned object instance
+func (stmt *Stmt) outputObjectBind(bind *bindStruct) error {
+	instance := *(*unsafe.Pointer)(bind.objectInstance)
+	var nullStruct unsafe.Pointer
+	if bind.objectIndicator != nil {
+		nullStruct = *(*unsafe.Pointer)(bind.objectIndicator)
+	}
+
+	value, err := stmt.conn.objectToGo(bind.objectType, instance, nullStruct)
+	if err != nil {
+		return err
+	}
+
+	switch dest := bind.out.Dest.(type) {
+	case *Object:
+		return dest.Scan(value)
+	case *Collection:
+		return dest.Scan(value)
+	}
+
+	dest := reflect.ValueOf(bind.out.Dest)
+	if dest.Kind() != reflect.Ptr || dest.IsNil() {
+		return fmt.Errorf("unsupported object out destination %T", bind.out.Dest)
+	}
+	return assignObjectValue(dest.Elem(), value)
+}
+
+// objectDefine fills the define for an object or collection column
+func (stmt *Stmt) objectDefine(define *defineStruct, param *C.OCIParam) error {
+	typeName, err := stmt.conn.ociAttrGetString(param, C.OCI_ATTR_TYPE_NAME)
+	if err != nil {
+		return err
+	}
+	var schemaName string
+	schemaName, err = stmt.conn.ociAttrGetString(param, C.OCI_ATTR_SCHEMA_NAME)
+	if err != nil {
+		return err
+	}
+	define.objectType, err = stmt.conn.getObjectTypeByName(schemaName, typeName)
+	if err != nil {
+		return err
+	}
+
+	// the null status is in the null structure of the object instance instead of the indicator
+	C.free(unsafe.Pointer(define.length))
+	C.free(unsafe.Pointer(define.indicator))
+	define.length = nil
+	define.indicator = nil
+	define.dataType = C.SQLT_NTY
+	define.maxSize = 0
+	// OCI allocates the object instance and null structure during the fetch
+	define.objectInstance = C.malloc(C.size_t(sizeOfNilPointer))
+	*(*unsafe.Pointer)(define.objectInstance) = nil
+	define.objectIndicator = C.malloc(C.size_t(sizeOfNilPointer))
+	*(*unsafe.Pointer)(define.objectIndicator) = nil
+
+	return nil
+}
+
+// ociDefineObject calls OCIDefineObject to set the object instance and null structure pointers of an object define
+func (stmt *Stmt) ociDefineObject(define *defineStruct) error {
+	result := C.OCIDefineObject(
+		define.defineHandle,                      // the define handle
+		stmt.conn.errHandle,                      // error handle
+		define.objectType.tdo,                    // the type descriptor object of the column
+		(*unsafe.Pointer)(define.objectInstance), // pointer to the pointer of the object instance
+		nil,                                      // size of the object instance, not needed
+		(*unsafe.Pointer)(define.objectIndicator), // pointer to the pointer of the null structure
+		nil, // size of the null structure, not needed
+	)
+	return stmt.conn.getError(result)
+}
+
+// objectValue converts the fetched object instance of the define, then frees the instance
+func (define *defineStruct) objectValue() (interface{}, error) {
+	conn := define.objectType.conn
+	instance := *(*unsafe.Pointer)(define.objectInstance)
+	nullStruct := *(*unsafe.Pointer)(define.objectIndicator)
+	if instance == nil {
+		return nil, nil
+	}
+
+	value, err := conn.objectToGo(define.objectType, instance, nullStruct)
+
+	C.OCIObjectFree(conn.env, conn.errHandle, instance, C.OCI_OBJECTFREE_FORCE)
+	*(*unsafe.Pointer)(define.objectInstance) = nil
+	*(*unsafe.Pointer)(define.objectIndicator) = nil
+
+	if err != nil || value == nil {
+		return value, err
+	}
+
+	// registered Go types are returned instead of an *Object or *Collection
+	goType := define.objectType.goType()
+	if goType == nil {
+		return value, nil
+	}
+	goValue := reflect.New(goType).Elem()
+	err = assignObjectValue(goValue, value)
+	if err != nil {
+		return nil, err
+	}
+	return goValue.Interface(), nil
+}
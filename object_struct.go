@@ -0,0 +1,221 @@
+package oci8
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// RegisterObjectType registers a Go struct type, or a named slice type, as the Go type of an Oracle object or collection type.
+// The type name is the optionally schema qualified name of the Oracle type. Value is a value of or a pointer to the Go type.
+//
+// Struct fields are mapped to object attributes with the oci8 tag, like `oci8:"ATTR_NAME"`.
+// Fields without an oci8 tag, or with a tag of "-", are ignored.
+// Fields for nested object attributes are structs or pointers to structs, and fields for collection attributes are slices.
+// Nested types do not need to be registered.
+//
+// Registered types can be used as bind values, as sql.Out destinations, and are returned for object and collection columns
+// instead of an *Object or *Collection, so they can be scanned into the struct or a pointer to the struct.
+func RegisterObjectType(typeName string, value interface{}) error {
+	if typeName == "" {
+		return errors.New("type name is empty")
+	}
+
+	goType := reflect.TypeOf(value)
+	if goType != nil && goType.Kind() == reflect.Ptr {
+		goType = goType.Elem()
+	}
+	if goType == nil || (goType.Kind() != reflect.Struct && goType.Kind() != reflect.Slice) ||
+		goType == typeObject || goType == typeCollection {
+		return fmt.Errorf("cannot register %T, must be a struct or a named slice type", value)
+	}
+	if goType.Kind() == reflect.Slice && goType.Name() == "" {
+		// unnamed slices are bound as arrays
+		return fmt.Errorf("cannot register %T, slice types must be named", value)
+	}
+
+	schemaName, name := splitTypeName(typeName)
+
+	objectStructTypes.Lock()
+	objectStructTypes.typeNames[goType] = typeName
+	objectStructTypes.goTypes[schemaName+"."+name] = goType
+	objectStructTypes.Unlock()
+
+	return nil
+}
+
+// registeredObjectTypeName returns the registered Oracle type name of the Go type
+func registeredObjectTypeName(goType reflect.Type) (string, bool) {
+	if goType == nil {
+		return "", false
+	}
+	objectStructTypes.RLock()
+	typeName, ok := objectStructTypes.typeNames[goType]
+	objectStructTypes.RUnlock()
+	return typeName, ok
+}
+
+// isObjectBindType returns true if the type, or the type a pointer points to, is registered with RegisterObjectType
+func isObjectBindType(goType reflect.Type) bool {
+	if goType != nil && goType.Kind() == reflect.Ptr {
+		goType = goType.Elem()
+	}
+	_, ok := registeredObjectTypeName(goType)
+	return ok
+}
+
+// goType returns the registered Go type of the object type, or nil if not registered.
+// Types registered with a schema are matched first, then types registered without a schema.
+func (objType *objectType) goType() reflect.Type {
+	objectStructTypes.RLock()
+	defer objectStructTypes.RUnlock()
+
+	if goType, ok := objectStructTypes.goTypes[objType.name]; ok {
+		return goType
+	}
+	return objectStructTypes.goTypes["."+objType.typeName]
+}
+
+// isNilValue returns true if the value is nil or a nil pointer, slice, or map
+func isNilValue(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Map, reflect.Interface:
+		return rv.IsNil()
+	}
+	return false
+}
+
+// structAttributes returns the attributes of a struct, or a pointer to a struct, keyed by the oci8 tag.
+// Returns nil attributes for a nil pointer.
+func structAttributes(value interface{}) (map[string]interface{}, error) {
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil, nil
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("cannot convert %T to attributes", value)
+	}
+
+	structType := rv.Type()
+	attributes := make(map[string]interface{}, structType.NumField())
+	for i := 0; i < structType.NumField(); i++ {
+		name := structFieldAttributeName(structType.Field(i))
+		if name == "" {
+			continue
+		}
+		attributes[name] = rv.Field(i).Interface()
+	}
+
+	return attributes, nil
+}
+
+// structFieldAttributeName returns the attribute name from the oci8 tag of the field, or an empty string if the field is not mapped
+func structFieldAttributeName(field reflect.StructField) string {
+	if field.PkgPath != "" {
+		// unexported
+		return ""
+	}
+	name := field.Tag.Get("oci8")
+	if name == "-" {
+		return ""
+	}
+	return name
+}
+
+// assignObjectValue sets dest to a value returned by objectToGo.
+// *Object values are assigned to structs by the oci8 tags of the fields and *Collection values are assigned to slices.
+func assignObjectValue(dest reflect.Value, value interface{}) error {
+	if value == nil {
+		dest.Set(reflect.Zero(dest.Type()))
+		return nil
+	}
+
+	src := reflect.ValueOf(value)
+	if src.Type().AssignableTo(dest.Type()) {
+		dest.Set(src)
+		return nil
+	}
+
+	if dest.Kind() == reflect.Ptr {
+		if dest.IsNil() {
+			dest.Set(reflect.New(dest.Type().Elem()))
+		}
+		return assignObjectValue(dest.Elem(), value)
+	}
+
+	if dest.CanAddr() && dest.Addr().Type().Implements(typeScanner) {
+		return dest.Addr().Interface().(sql.Scanner).Scan(value)
+	}
+
+	switch goValue := value.(type) {
+
+	case *Object:
+		if dest.Kind() != reflect.Struct {
+			return fmt.Errorf("cannot assign object type %v to %v", goValue.TypeName, dest.Type())
+		}
+		return assignStructFields(dest, goValue)
+
+	case *Collection:
+		if dest.Kind() != reflect.Slice {
+			return fmt.Errorf("cannot assign collection type %v to %v", goValue.TypeName, dest.Type())
+		}
+		slice := reflect.MakeSlice(dest.Type(), len(goValue.Elements), len(goValue.Elements))
+		for i := 0; i < len(goValue.Elements); i++ {
+			err := assignObjectValue(slice.Index(i), goValue.Elements[i])
+			if err != nil {
+				return fmt.Errorf("element %v - error: %v", i, err)
+			}
+		}
+		dest.Set(slice)
+		return nil
+
+	}
+
+	// numbers to other number types, and strings or bytes to string types
+	if src.Type().ConvertibleTo(dest.Type()) && (dest.Kind() != reflect.String || src.Kind() == reflect.String || src.Type() == typeSliceByte) {
+		dest.Set(src.Convert(dest.Type()))
+		return nil
+	}
+
+	return fmt.Errorf("cannot assign %T to %v", value, dest.Type())
+}
+
+// assignStructFields sets the fields of the struct to the attributes of the object by the oci8 tags of the fields
+func assignStructFields(dest reflect.Value, object *Object) error {
+	structType := dest.Type()
+	for i := 0; i < structType.NumField(); i++ {
+		name := structFieldAttributeName(structType.Field(i))
+		if name == "" {
+			continue
+		}
+
+		value, ok := object.Attributes[name]
+		if !ok {
+			for key := range object.Attributes {
+				if strings.EqualFold(key, name) {
+					value, ok = object.Attributes[key], true
+					break
+				}
+			}
+			if !ok {
+				return fmt.Errorf("attribute %v not found in type %v", name, object.TypeName)
+			}
+		}
+
+		err := assignObjectValue(dest.Field(i), value)
+		if err != nil {
+			return fmt.Errorf("field %v - error: %v", structType.Field(i).Name, err)
+		}
+	}
+
+	return nil
+}
@@ -4,6 +4,8 @@ package oci8
 import "C"
 
 import (
+	"context"
+	"database/sql"
 	"database/sql/driver"
 	"errors"
 	"fmt"
@@ -13,6 +15,7 @@ import (
 	"strconv"
 	"strings"
 	"time"
+	"unicode"
 	"unsafe"
 )
 
@@ -35,7 +38,39 @@ import (
 //
 // prefetch_memory - the max memory for top level rows to be prefetched. Defaults to 4096. A 0 means unlimited memory.
 //
+// fetch_array_size - the number of rows fetched into the driver buffers per fetch call. Defaults to 1.
+// Can be overridden per query with the FetchArraySize option.
+//
+// exact_number - when true, NUMBER columns that may not fit in an int64, like NUMBER(18,2), FLOAT, and sum results,
+// are fetched as Number instead of float64. Defaults to false. Can be enabled per query with the ExactNumber option.
+// (uses strconv.ParseBool to check for true)
+//
+// native_bool - when true, bool values are bound as the native BOOLEAN type if the client and server support it:
+// version 12.1 or later for PL/SQL, 23 or later for SQL. Otherwise bool values are bound as 0 or 1. Defaults to false.
+// (uses strconv.ParseBool to check for true)
+//
+// session_tz - the session time zone set at connect, like America/New_York, +05:00, LOCAL, or DBTIMEZONE.
+// The session time zone is used for TIMESTAMP WITH LOCAL TIME ZONE values and conversions like SYSTIMESTAMP to a TIMESTAMP.
+// Defaults to the time zone of the client environment, ORA_SDTZ or the operating system time zone.
+//
+// lob_fetch - how BLOB, CLOB, and NCLOB columns are fetched: data or locator. Defaults to data.
+// With data the content is read into a []byte or string. With locator the columns are fetched as *Lob without reading the content.
+// Can be enabled per query with the LobLocator option.
+//
+// lob_prefetch_size - the size of the LOB data fetched with the LOB locators, so LOBs up to the size are read without more round trips.
+// Defaults to 0, no prefetch. Can be overridden per query with the LobPrefetchSize option.
+//
+// time_bind - the type time.Time values are bound as: date, timestamp, or timestamp_tz. Defaults to timestamp_tz.
+// Binding as date lets comparisons with an indexed DATE column use the index.
+// The Date, Timestamp, and TimestampTZ types are bound as their type whatever the time_bind.
+//
 // questionph - when true, enables question mark placeholders. Defaults to false. (uses strconv.ParseBool to check for true)
+//
+// dollarph - when true, enables $1, $2, ... $n placeholders. Defaults to false. (uses strconv.ParseBool to check for true)
+//
+// atph - when true, enables @name placeholders. Defaults to false. (uses strconv.ParseBool to check for true)
+//
+// Placeholders inside string literals, quoted identifiers, and comments are not converted.
 func ParseDSN(dsnString string) (dsn *DSN, err error) {
 
 	if dsnString == "" {
@@ -54,6 +89,8 @@ func ParseDSN(dsnString string) (dsn *DSN, err error) {
 		stmtCacheSize:  0,
 		operationMode:  C.OCI_DEFAULT,
 		timeLocation:   time.UTC,
+		fetchArraySize: 1,
+		timeBind:       defaultTimeBind,
 	}
 
 	authority, dsnString := splitRight(dsnString, "@")
@@ -97,6 +134,16 @@ func ParseDSN(dsnString string) (dsn *DSN, err error) {
 			if err != nil {
 				return nil, fmt.Errorf("Invalid questionph: %v", v[0])
 			}
+		case "dollarph":
+			dsn.enableDollarPlaceholders, err = strconv.ParseBool(v[0])
+			if err != nil {
+				return nil, fmt.Errorf("Invalid dollarph: %v", v[0])
+			}
+		case "atph":
+			dsn.enableAtPlaceholders, err = strconv.ParseBool(v[0])
+			if err != nil {
+				return nil, fmt.Errorf("Invalid atph: %v", v[0])
+			}
 		case "prefetch_rows":
 			z, err := strconv.ParseUint(v[0], 10, 32)
 			if err != nil {
@@ -126,6 +173,50 @@ func ParseDSN(dsnString string) (dsn *DSN, err error) {
 				return nil, fmt.Errorf("invalid stmt_cache_size: %v", v[0])
 			}
 			dsn.stmtCacheSize = C.ub4(z)
+		case "fetch_array_size":
+			z, err := strconv.ParseUint(v[0], 10, 16)
+			if err != nil || z < 1 {
+				return nil, fmt.Errorf("invalid fetch_array_size: %v", v[0])
+			}
+			dsn.fetchArraySize = int(z)
+		case "exact_number":
+			dsn.exactNumber, err = strconv.ParseBool(v[0])
+			if err != nil {
+				return nil, fmt.Errorf("invalid exact_number: %v", v[0])
+			}
+		case "native_bool":
+			dsn.nativeBool, err = strconv.ParseBool(v[0])
+			if err != nil {
+				return nil, fmt.Errorf("invalid native_bool: %v", v[0])
+			}
+		case "session_tz":
+			dsn.sessionTimeZone = v[0]
+		case "lob_fetch":
+			switch v[0] {
+			case "locator":
+				dsn.lobLocator = true
+			case "data":
+				dsn.lobLocator = false
+			default:
+				return nil, fmt.Errorf("invalid lob_fetch: %v", v[0])
+			}
+		case "lob_prefetch_size":
+			z, err := strconv.ParseUint(v[0], 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("invalid lob_prefetch_size: %v", v[0])
+			}
+			dsn.lobPrefetchSize = C.ub4(z)
+		case "time_bind":
+			switch v[0] {
+			case "date":
+				dsn.timeBind = C.SQLT_ODT
+			case "timestamp":
+				dsn.timeBind = C.SQLT_TIMESTAMP
+			case "timestamp_tz":
+				dsn.timeBind = C.SQLT_TIMESTAMP_TZ
+			default:
+				return nil, fmt.Errorf("invalid time_bind: %v", v[0])
+			}
 		}
 	}
 
@@ -413,18 +504,155 @@ func (drv *DriverStruct) Open(dsnString string) (driver.Conn, error) {
 	conn.prefetchMemory = dsn.prefetchMemory
 	conn.timeLocation = dsn.timeLocation
 	conn.enableQMPlaceholders = dsn.enableQMPlaceholders
+	conn.enableDollarPlaceholders = dsn.enableDollarPlaceholders
+	conn.enableAtPlaceholders = dsn.enableAtPlaceholders
+	conn.fetchArraySize = dsn.fetchArraySize
+	conn.exactNumber = dsn.exactNumber
+	conn.timeBind = dsn.timeBind
+	conn.lobLocator = dsn.lobLocator
+	conn.lobPrefetchSize = dsn.lobPrefetchSize
+
+	if dsn.sessionTimeZone != "" {
+		err = conn.setSessionTimeZone(dsn.sessionTimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("session time zone error: %v", err)
+		}
+	}
+
+	if dsn.nativeBool {
+		conn.nativeBool = true
+		conn.clientVersion = ociClientVersion()
+		conn.serverVersion, err = conn.ociServerRelease()
+		if err != nil {
+			return nil, fmt.Errorf("server release error: %v", err)
+		}
+	}
 
 	return &conn, nil
 }
 
-// GetLastInsertId returns rowid from LastInsertId
+// GetLastInsertId returns an empty string, since LastInsertId returns an error.
+//
+// Deprecated: use ExecRowID or InsertReturning
 func GetLastInsertId(id int64) string {
-	return *(*string)(unsafe.Pointer(uintptr(id)))
+	return ""
 }
 
-// LastInsertId returns last inserted ID
+// LastInsertId returns ErrNoLastInsertId, Oracle has no id of the last inserted row.
+// Use InsertReturning to get a numeric or identity key, or ExecRowID to get the rowid.
 func (result *Result) LastInsertId() (int64, error) {
-	return int64(uintptr(unsafe.Pointer(&result.rowid))), result.rowidErr
+	return 0, ErrNoLastInsertId
+}
+
+// RowID returns the rowid of the last row affected by an insert, update, or delete.
+// database/sql wraps the driver.Result, so with database/sql use ExecRowID instead.
+func (result *Result) RowID() (string, error) {
+	return result.rowid, result.rowidErr
+}
+
+// ExecRowID runs an insert, update, or delete of one row with a RETURNING ROWID clause added to the query,
+// and returns the rowid of the row. The query follows the rules of InsertReturning.
+// Returns ErrNoRowid if no row was affected.
+//
+//	rowid, result, err := oci8.ExecRowID(ctx, db, "insert into people ( name ) values (:1)", "Bob")
+func ExecRowID(ctx context.Context, execer ContextExecer, query string, args ...interface{}) (string, sql.Result, error) {
+	var rowid string
+	result, err := InsertReturning(ctx, execer, query, "rowid", &rowid, args...)
+	if err != nil {
+		return "", nil, err
+	}
+	if rowid == "" {
+		return "", result, ErrNoRowid
+	}
+	return rowid, result, nil
+}
+
+// InsertReturning runs an insert, update, or delete with a RETURNING clause for the column added to the query.
+// The returned value is stored into dest, like a pointer to an int64 for a numeric or identity key.
+// For array DML, or a statement that affects many rows, dest is a pointer to a slice.
+//
+// The query must be a single insert, update, or delete. Trailing white space and a trailing semicolon are removed.
+// An error is returned without running the query when it already has a RETURNING clause,
+// or when it is an INSERT ... SELECT, which Oracle does not allow with RETURNING.
+//
+//	var id int64
+//	result, err := oci8.InsertReturning(ctx, db, "insert into people ( name ) values (:1)", "id", &id, "Bob")
+func InsertReturning(ctx context.Context, execer ContextExecer, query string, column string, dest interface{}, args ...interface{}) (sql.Result, error) {
+	query, err := insertReturningQuery(query, column)
+	if err != nil {
+		return nil, err
+	}
+	args = append(args, sql.Named("oci8_returning", sql.Out{Dest: dest}))
+	return execer.ExecContext(ctx, query, args...)
+}
+
+// insertReturningQuery adds the RETURNING clause for column to query, or returns an error if query cannot have one
+func insertReturningQuery(query string, column string) (string, error) {
+	query = strings.TrimRight(query, " \t\r\n;")
+	words := queryWords(query)
+	if len(words) == 0 {
+		return "", errors.New("InsertReturning query is empty")
+	}
+	if words[0] != "insert" && words[0] != "update" && words[0] != "delete" {
+		return "", fmt.Errorf("InsertReturning query must be an insert, update, or delete, not %v", words[0])
+	}
+	valuesIndex := -1
+	selectIndex := -1
+	for i, word := range words {
+		switch word {
+		case "returning", "return":
+			return "", errors.New("InsertReturning query already has a RETURNING clause")
+		case "values":
+			if valuesIndex < 0 {
+				valuesIndex = i
+			}
+		case "select":
+			if selectIndex < 0 {
+				selectIndex = i
+			}
+		}
+	}
+	if words[0] == "insert" && selectIndex >= 0 && (valuesIndex < 0 || selectIndex < valuesIndex) {
+		return "", errors.New("InsertReturning query cannot be an INSERT ... SELECT, Oracle does not allow RETURNING with it")
+	}
+	return query + " returning " + column + " into :oci8_returning", nil
+}
+
+// queryWords returns the lower case words of query, skipping string literals, quoted identifiers, and comments
+func queryWords(query string) []string {
+	var words []string
+	var word []rune
+	endWord := func() {
+		if len(word) > 0 {
+			words = append(words, strings.ToLower(string(word)))
+			word = word[:0]
+		}
+	}
+	runes := []rune(query)
+	for i := 0; i < len(runes); i++ {
+		switch {
+		case runes[i] == '\'' || runes[i] == '"':
+			endWord()
+			quote := runes[i]
+			for i++; i < len(runes) && runes[i] != quote; i++ {
+			}
+		case runes[i] == '-' && i+1 < len(runes) && runes[i+1] == '-':
+			endWord()
+			for i++; i < len(runes) && runes[i] != '\n'; i++ {
+			}
+		case runes[i] == '/' && i+1 < len(runes) && runes[i+1] == '*':
+			endWord()
+			for i += 2; i < len(runes) && !(runes[i] == '*' && i+1 < len(runes) && runes[i+1] == '/'); i++ {
+			}
+			i++
+		case unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_' || runes[i] == '$' || runes[i] == '#':
+			word = append(word, runes[i])
+		default:
+			endWord()
+		}
+	}
+	endWord()
+	return words
 }
 
 // RowsAffected returns rows affected
@@ -432,15 +660,6 @@ func (result *Result) RowsAffected() (int64, error) {
 	return result.rowsAffected, result.rowsAffectedErr
 }
 
-// converts "?" characters to  :1, :2, ... :n
-func placeholders(sql string) string {
-	n := 0
-	return phre.ReplaceAllStringFunc(sql, func(string) string {
-		n++
-		return ":" + strconv.Itoa(n)
-	})
-}
-
 func timezoneToLocation(hour int64, minute int64) *time.Location {
 	if minute != 0 || hour > 14 || hour < -12 {
 		// create location with FixedZone
@@ -464,3 +683,35 @@ func timezoneToLocation(hour int64, minute int64) *time.Location {
 	// use location from timeLocations cache
 	return timeLocations[12+hour]
 }
+
+// regionLocation returns the time location of an Oracle time zone region name, like America/New_York.
+// Returns nil if the name is an offset or the region cannot be loaded.
+func regionLocation(name string) *time.Location {
+	if name == "" || name[0] == '+' || name[0] == '-' {
+		return nil
+	}
+	if location, ok := regionLocations.Load(name); ok {
+		return location.(*time.Location)
+	}
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		location = nil
+	}
+	regionLocations.Store(name, location)
+	return location
+}
+
+// timeZoneRegion returns the time zone region name of a time location, like America/New_York,
+// or an empty string if the location is not a region, like Local, UTC, a fixed zone, or a timeLocations offset
+func timeZoneRegion(location *time.Location) string {
+	name := location.String()
+	if !strings.Contains(name, "/") {
+		return ""
+	}
+	for i := 0; i < len(timeLocations); i++ {
+		if location == timeLocations[i] {
+			return ""
+		}
+	}
+	return name
+}
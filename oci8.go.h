@@ -1,2 +1,22 @@
+#ifndef OCI8_GO_H
+#define OCI8_GO_H
+
 #include <oci.h>
 #include <stdlib.h>
+
+// oci8Returning holds the values of a DML RETURNING out bind, filled by the OCIBindDynamic out callback
+typedef struct {
+	OCIEnv   *env;
+	OCIError *errHandle;
+	ub4       descriptorType; // descriptor type of the elements, 0 if the elements are not descriptors
+	ub4       maxSize;        // size of each element in the buffer
+	ub4       rows;           // number of elements returned by all iterations
+	ub4       base;           // index of the first element of the current iteration
+	ub4       capacity;       // number of elements allocated
+	char     *buffer;
+	ub4      *lengths;
+	sb2      *indicators;
+	ub2      *returnCodes;
+} oci8Returning;
+
+#endif
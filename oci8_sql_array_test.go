@@ -0,0 +1,478 @@
+package oci8
+
+import (
+	"context"
+	"database/sql"
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestDestructiveArrayInsert tests array DML inserts
+func TestDestructiveArrayInsert(t *testing.T) {
+	if TestDisableDatabase || TestDisableDestructive {
+		t.SkipNow()
+	}
+
+	tableName := "array_insert_" + TestTimeString
+	err := testExec(t, "create table "+tableName+" ( A INTEGER, B VARCHAR2(100), C BINARY_DOUBLE, D TIMESTAMP(9) WITH TIME ZONE )", nil)
+	if err != nil {
+		t.Fatal("create table error:", err)
+	}
+
+	defer testDropTable(t, tableName)
+
+	aTime := time.Date(2006, 1, 2, 3, 4, 5, 123456789, time.UTC)
+
+	ctx, cancel := context.WithTimeout(context.Background(), TestContextTimeout)
+	stmt, err := TestDB.PrepareContext(ctx, "insert into "+tableName+" ( A, B, C, D ) values (:1, :2, :3, :4)")
+	cancel()
+	if err != nil {
+		t.Fatal("prepare error:", err)
+	}
+
+	var result sql.Result
+	ctx, cancel = context.WithTimeout(context.Background(), TestContextTimeout)
+	result, err = stmt.ExecContext(ctx,
+		[]int64{1, 2, 3},
+		[]sql.NullString{{String: "a", Valid: true}, {}, {String: "ccc", Valid: true}},
+		[]float64{1.5, 2.5, 3.5},
+		[]time.Time{aTime, aTime.Add(time.Hour), aTime.Add(2 * time.Hour)},
+	)
+	cancel()
+	if err != nil {
+		stmt.Close()
+		t.Fatal("exec error:", err)
+	}
+
+	err = stmt.Close()
+	if err != nil {
+		t.Fatal("stmt close error:", err)
+	}
+
+	var count int64
+	count, err = result.RowsAffected()
+	if err != nil {
+		t.Fatal("rows affected error:", err)
+	}
+	if count != 3 {
+		t.Fatalf("rows affected: received: %v - expected: %v", count, 3)
+	}
+
+	queryResults := testQueryResults{
+		query: "select A, B, C, D from " + tableName + " order by A",
+		queryResults: []testQueryResult{
+			{
+				results: [][]interface{}{
+					{int64(1), "a", float64(1.5), aTime},
+					{int64(2), nil, float64(2.5), aTime.Add(time.Hour)},
+					{int64(3), "ccc", float64(3.5), aTime.Add(2 * time.Hour)},
+				},
+			},
+		},
+	}
+	testRunQueryResults(t, queryResults)
+
+	// mismatched array lengths
+	err = testExec(t, "insert into "+tableName+" ( A, B ) values (:1, :2)", []interface{}{[]int64{4, 5}, []string{"d"}})
+	if err == nil {
+		t.Fatal("expected error for mismatched array lengths")
+	}
+
+	// empty arrays do not execute
+	err = testExec(t, "insert into "+tableName+" ( A ) values (:1)", []interface{}{[]int64{}})
+	if err != nil {
+		t.Fatal("exec empty array error:", err)
+	}
+}
+
+// TestDestructiveArrayLargeIntegers tests array DML with uint64 and big.Int elements greater than max int64
+func TestDestructiveArrayLargeIntegers(t *testing.T) {
+	if TestDisableDatabase || TestDisableDestructive {
+		t.SkipNow()
+	}
+
+	tableName := "array_large_" + TestTimeString
+	err := testExec(t, "create table "+tableName+" ( A INTEGER, B NUMBER(20), C NUMBER(38) )", nil)
+	if err != nil {
+		t.Fatal("create table error:", err)
+	}
+
+	defer testDropTable(t, tableName)
+
+	bigInt, _ := new(big.Int).SetString("-12345678901234567890123456789012345678", 10)
+
+	var returned []uint64
+	ctx, cancel := context.WithTimeout(context.Background(), TestContextTimeout)
+	_, err = TestDB.ExecContext(ctx, "insert into "+tableName+" ( A, B, C ) values (:1, :2, :3) returning B into :4",
+		[]int64{1, 2}, []uint64{math.MaxUint64, 5}, []*big.Int{bigInt, nil}, sql.Out{Dest: &returned})
+	cancel()
+	if err != nil {
+		t.Fatal("insert error:", err)
+	}
+	if len(returned) != 2 || returned[0] != math.MaxUint64 || returned[1] != 5 {
+		t.Errorf("returned: received: %v - expected: %v", returned, []uint64{math.MaxUint64, 5})
+	}
+
+	queryResults := testQueryResults{
+		query: "select to_char(B), to_char(C) from " + tableName + " order by A",
+		queryResults: []testQueryResult{
+			{
+				results: [][]interface{}{
+					{"18446744073709551615", bigInt.String()},
+					{"5", nil},
+				},
+			},
+		},
+	}
+	testRunQueryResults(t, queryResults)
+}
+
+// TestDestructiveArrayTimeBind tests array DML and DML RETURNING with Date, Timestamp, and TimestampTZ elements and time_bind
+func TestDestructiveArrayTimeBind(t *testing.T) {
+	if TestDisableDatabase || TestDisableDestructive {
+		t.SkipNow()
+	}
+
+	tableName := "array_time_" + TestTimeString
+	err := testExec(t, "create table "+tableName+" ( A INTEGER, B VARCHAR2(200), C DATE )", nil)
+	if err != nil {
+		t.Fatal("create table error:", err)
+	}
+
+	defer testDropTable(t, tableName)
+
+	db := testGetDB("?time_bind=date")
+	if db == nil {
+		t.Fatal("db is null")
+	}
+	defer db.Close()
+
+	aTime := time.Date(2006, 1, 2, 3, 4, 5, 123456789, time.UTC)
+
+	// dump type 12 is DATE, 180 is TIMESTAMP, and 181 is TIMESTAMP WITH TIME ZONE
+	tests := []struct {
+		db       *sql.DB
+		arg      interface{}
+		dumpType string
+	}{
+		{db: TestDB, arg: []time.Time{aTime}, dumpType: "Typ=181 "},
+		{db: TestDB, arg: []Date{Date(aTime)}, dumpType: "Typ=12 "},
+		{db: TestDB, arg: []*Timestamp{(*Timestamp)(&aTime)}, dumpType: "Typ=180 "},
+		{db: TestDB, arg: []TimestampTZ{TimestampTZ(aTime)}, dumpType: "Typ=181 "},
+		{db: db, arg: []time.Time{aTime}, dumpType: "Typ=12 "},
+	}
+	for i, test := range tests {
+		ctx, cancel := context.WithTimeout(context.Background(), TestContextTimeout)
+		_, err = test.db.ExecContext(ctx, "insert into "+tableName+" ( A, B ) values (:1, dump(:2))", []int64{int64(i)}, test.arg)
+		cancel()
+		if err != nil {
+			t.Fatalf("insert error for %T: %v", test.arg, err)
+		}
+
+		var dump string
+		ctx, cancel = context.WithTimeout(context.Background(), TestContextTimeout)
+		err = TestDB.QueryRowContext(ctx, "select B from "+tableName+" where A = :1", i).Scan(&dump)
+		cancel()
+		if err != nil {
+			t.Fatalf("query error for %T: %v", test.arg, err)
+		}
+		if !strings.HasPrefix(dump, test.dumpType) {
+			t.Errorf("dump for %T: received: %v - expected: %v", test.arg, dump, test.dumpType)
+		}
+	}
+
+	// returning into Date and, with time_bind=date, into time.Time
+	var dates []Date
+	ctx, cancel := context.WithTimeout(context.Background(), TestContextTimeout)
+	_, err = TestDB.ExecContext(ctx, "insert into "+tableName+" ( A, C ) values (:1, :2) returning C into :3",
+		[]int64{10, 11}, []Date{Date(aTime), Date(aTime.Add(time.Hour))}, sql.Out{Dest: &dates})
+	cancel()
+	if err != nil {
+		t.Fatal("insert returning error:", err)
+	}
+	if len(dates) != 2 || !dates[0].Time().Equal(aTime.Truncate(time.Second)) || !dates[1].Time().Equal(aTime.Add(time.Hour).Truncate(time.Second)) {
+		t.Errorf("dates: received: %v - expected: %v, %v", dates, aTime.Truncate(time.Second), aTime.Add(time.Hour).Truncate(time.Second))
+	}
+
+	var times []time.Time
+	ctx, cancel = context.WithTimeout(context.Background(), TestContextTimeout)
+	_, err = db.ExecContext(ctx, "update "+tableName+" set C = C + 1 where A = 10 returning C into :1", sql.Out{Dest: &times})
+	cancel()
+	if err != nil {
+		t.Fatal("update returning error:", err)
+	}
+	if len(times) != 1 || !times[0].Equal(aTime.Truncate(time.Second).AddDate(0, 0, 1)) {
+		t.Errorf("times: received: %v - expected: %v", times, aTime.Truncate(time.Second).AddDate(0, 0, 1))
+	}
+}
+
+// TestDestructiveArrayBatchErrors tests array DML with batch errors and row counts
+func TestDestructiveArrayBatchErrors(t *testing.T) {
+	if TestDisableDatabase || TestDisableDestructive {
+		t.SkipNow()
+	}
+
+	tableName := "array_batch_" + TestTimeString
+	err := testExec(t, "create table "+tableName+" ( A INTEGER PRIMARY KEY, B VARCHAR2(10) )", nil)
+	if err != nil {
+		t.Fatal("create table error:", err)
+	}
+
+	defer testDropTable(t, tableName)
+
+	ctx, cancel := context.WithTimeout(context.Background(), TestContextTimeout)
+	stmt, err := TestDB.PrepareContext(ctx, "insert into "+tableName+" ( A, B ) values (:1, :2)")
+	cancel()
+	if err != nil {
+		t.Fatal("prepare error:", err)
+	}
+
+	// row 2 is a duplicate key and row 3 is too large for the column
+	ctx, cancel = context.WithTimeout(context.Background(), TestContextTimeout)
+	_, err = stmt.ExecContext(ctx, []int64{1, 2, 1, 3, 4}, []string{"a", "b", "c", "ddddddddddddddd", "e"}, BatchErrors())
+	cancel()
+	stmt.Close()
+	batchError, ok := err.(*BatchError)
+	if !ok {
+		t.Fatalf("exec error: received: %T, %v - expected: *BatchError", err, err)
+	}
+	if batchError.RowsAffected != 3 {
+		t.Fatalf("rows affected: received: %v - expected: %v", batchError.RowsAffected, 3)
+	}
+	if len(batchError.Errors) != 2 {
+		t.Fatalf("row errors len: received: %v - expected: %v", len(batchError.Errors), 2)
+	}
+	if batchError.Errors[0].Offset != 2 || batchError.Errors[0].Code != 1 {
+		t.Fatalf("row error 0: received: %v, %v - expected: 2, 1", batchError.Errors[0].Offset, batchError.Errors[0].Code)
+	}
+	if batchError.Errors[1].Offset != 3 || batchError.Errors[1].Code != 12899 {
+		t.Fatalf("row error 1: received: %v, %v - expected: 3, 12899", batchError.Errors[1].Offset, batchError.Errors[1].Code)
+	}
+
+	queryResults := testQueryResults{
+		query: "select A, B from " + tableName + " order by A",
+		queryResults: []testQueryResult{
+			{
+				results: [][]interface{}{
+					{int64(1), "a"},
+					{int64(2), "b"},
+					{int64(4), "e"},
+				},
+			},
+		},
+	}
+	testRunQueryResults(t, queryResults)
+
+	var rowCounts []int64
+	ctx, cancel = context.WithTimeout(context.Background(), TestContextTimeout)
+	_, err = TestDB.ExecContext(ctx, "update "+tableName+" set B = 'x' where A >= :1", []int64{1, 3, 4}, RowCounts(&rowCounts))
+	cancel()
+	if err != nil {
+		t.Fatal("exec error:", err)
+	}
+	expected := []int64{3, 1, 1}
+	if len(rowCounts) != len(expected) {
+		t.Fatalf("row counts: received: %v - expected: %v", rowCounts, expected)
+	}
+	for i := range expected {
+		if rowCounts[i] != expected[i] {
+			t.Fatalf("row counts: received: %v - expected: %v", rowCounts, expected)
+		}
+	}
+
+	// options of a failed exec do not apply to the next exec of the statement
+	ctx, cancel = context.WithTimeout(context.Background(), TestContextTimeout)
+	stmt, err = TestDB.PrepareContext(ctx, "update "+tableName+" set B = 'y' where A >= :1")
+	cancel()
+	if err != nil {
+		t.Fatal("prepare error:", err)
+	}
+	defer stmt.Close()
+
+	var staleRowCounts []int64
+	var badDest struct{}
+	for _, badValue := range []interface{}{make(chan int), sql.Out{Dest: &badDest}} {
+		ctx, cancel = context.WithTimeout(context.Background(), TestContextTimeout)
+		_, err = stmt.ExecContext(ctx, RowCounts(&staleRowCounts), badValue)
+		cancel()
+		if err == nil {
+			t.Fatalf("exec with %T: expected error", badValue)
+		}
+	}
+	ctx, cancel = context.WithTimeout(context.Background(), TestContextTimeout)
+	_, err = stmt.ExecContext(ctx, []int64{1, 3})
+	cancel()
+	if err != nil {
+		t.Fatal("exec error:", err)
+	}
+	if staleRowCounts != nil {
+		t.Fatalf("row counts of failed exec: received: %v - expected: nil", staleRowCounts)
+	}
+}
+
+// TestDestructivePlsqlArrays tests binding slices to PL/SQL associative arrays
+func TestDestructivePlsqlArrays(t *testing.T) {
+	if TestDisableDatabase || TestDisableDestructive {
+		t.SkipNow()
+	}
+
+	packageName := "PKG_ARRAYS_" + TestTimeString
+	err := testExec(t, `create or replace package `+packageName+` is
+	type t_strings is table of varchar2(100) index by pls_integer;
+	type t_numbers is table of number index by pls_integer;
+	type t_times is table of timestamp with time zone index by pls_integer;
+	procedure upper_strings(p_in in t_strings, p_out out t_strings);
+	procedure double_numbers(p_numbers in out t_numbers);
+	procedure add_day(p_in in t_times, p_out out t_times);
+end `+packageName+`;`, nil)
+	if err != nil {
+		t.Fatal("create package error:", err)
+	}
+
+	defer func() {
+		err := testExec(t, "drop package "+packageName, nil)
+		if err != nil {
+			t.Error("drop package error:", err)
+		}
+	}()
+
+	err = testExec(t, `create or replace package body `+packageName+` is
+	procedure upper_strings(p_in in t_strings, p_out out t_strings) is
+	begin
+		for i in 1 .. p_in.count loop
+			p_out(i) := upper(p_in(i));
+		end loop;
+	end upper_strings;
+	procedure double_numbers(p_numbers in out t_numbers) is
+	begin
+		for i in 1 .. p_numbers.count loop
+			p_numbers(i) := p_numbers(i) * 2;
+		end loop;
+		p_numbers(p_numbers.count + 1) := 0;
+	end double_numbers;
+	procedure add_day(p_in in t_times, p_out out t_times) is
+	begin
+		for i in 1 .. p_in.count loop
+			p_out(i) := p_in(i) + interval '1' day;
+		end loop;
+	end add_day;
+end `+packageName+`;`, nil)
+	if err != nil {
+		t.Fatal("create package body error:", err)
+	}
+
+	upperStrings := make([]string, 0, 10)
+	ctx, cancel := context.WithTimeout(context.Background(), TestContextTimeout)
+	_, err = TestDB.ExecContext(ctx, "begin "+packageName+".upper_strings(:1, :2); end;", []string{"a", "bc", "def"}, sql.Out{Dest: &upperStrings})
+	cancel()
+	if err != nil {
+		t.Fatal("exec error:", err)
+	}
+	if len(upperStrings) != 3 || upperStrings[0] != "A" || upperStrings[1] != "BC" || upperStrings[2] != "DEF" {
+		t.Fatalf("strings: received: %v - expected: %v", upperStrings, []string{"A", "BC", "DEF"})
+	}
+
+	numbers := make([]int64, 3, 10)
+	numbers[0], numbers[1], numbers[2] = 1, 2, 3
+	ctx, cancel = context.WithTimeout(context.Background(), TestContextTimeout)
+	_, err = TestDB.ExecContext(ctx, "begin "+packageName+".double_numbers(:1); end;", sql.Out{Dest: &numbers, In: true})
+	cancel()
+	if err != nil {
+		t.Fatal("exec error:", err)
+	}
+	if len(numbers) != 4 || numbers[0] != 2 || numbers[1] != 4 || numbers[2] != 6 || numbers[3] != 0 {
+		t.Fatalf("numbers: received: %v - expected: %v", numbers, []int64{2, 4, 6, 0})
+	}
+
+	aTime := time.Date(2006, 1, 2, 3, 4, 5, 123456789, time.UTC)
+	times := make([]time.Time, 0, 2)
+	ctx, cancel = context.WithTimeout(context.Background(), TestContextTimeout)
+	_, err = TestDB.ExecContext(ctx, "begin "+packageName+".add_day(:1, :2); end;", []time.Time{aTime, aTime.Add(time.Hour)}, sql.Out{Dest: &times})
+	cancel()
+	if err != nil {
+		t.Fatal("exec error:", err)
+	}
+	if len(times) != 2 || !times[0].Equal(aTime.AddDate(0, 0, 1)) || !times[1].Equal(aTime.Add(time.Hour).AddDate(0, 0, 1)) {
+		t.Fatalf("times: received: %v - expected: %v, %v", times, aTime.AddDate(0, 0, 1), aTime.Add(time.Hour).AddDate(0, 0, 1))
+	}
+
+	// out slices need capacity
+	var empty []string
+	ctx, cancel = context.WithTimeout(context.Background(), TestContextTimeout)
+	_, err = TestDB.ExecContext(ctx, "begin "+packageName+".upper_strings(:1, :2); end;", []string{"a"}, sql.Out{Dest: &empty})
+	cancel()
+	if err == nil {
+		t.Fatal("expected error for out slice without capacity")
+	}
+}
+
+// TestDestructiveReturning tests DML RETURNING into out slices
+func TestDestructiveReturning(t *testing.T) {
+	if TestDisableDatabase || TestDisableDestructive {
+		t.SkipNow()
+	}
+
+	tableName := "returning_" + TestTimeString
+	err := testExec(t, "create table "+tableName+" ( A INTEGER, B VARCHAR2(100), C TIMESTAMP(9) WITH TIME ZONE )", nil)
+	if err != nil {
+		t.Fatal("create table error:", err)
+	}
+
+	defer testDropTable(t, tableName)
+
+	aTime := time.Date(2006, 1, 2, 3, 4, 5, 123456789, time.UTC)
+
+	// array insert returning all rows
+	var ids []int64
+	var names []sql.NullString
+	ctx, cancel := context.WithTimeout(context.Background(), TestContextTimeout)
+	_, err = TestDB.ExecContext(ctx, "insert into "+tableName+" ( A, B, C ) values (:1, :2, :3) returning A, B into :4, :5",
+		[]int64{1, 2, 3}, []sql.NullString{{String: "a", Valid: true}, {}, {String: "c", Valid: true}}, []time.Time{aTime, aTime, aTime},
+		sql.Out{Dest: &ids}, sql.Out{Dest: &names})
+	cancel()
+	if err != nil {
+		t.Fatal("insert error:", err)
+	}
+
+	expectedNames := []sql.NullString{{String: "a", Valid: true}, {}, {String: "c", Valid: true}}
+	if len(ids) != 3 || ids[0] != 1 || ids[1] != 2 || ids[2] != 3 {
+		t.Errorf("ids: received: %v - expected: %v", ids, []int64{1, 2, 3})
+	}
+	if len(names) != 3 || names[0] != expectedNames[0] || names[1] != expectedNames[1] || names[2] != expectedNames[2] {
+		t.Errorf("names: received: %v - expected: %v", names, expectedNames)
+	}
+
+	// multi row update returning
+	var times []time.Time
+	ctx, cancel = context.WithTimeout(context.Background(), TestContextTimeout)
+	_, err = TestDB.ExecContext(ctx, "update "+tableName+" set A = A * 10 where A > 1 returning A, C into :1, :2",
+		sql.Out{Dest: &ids}, sql.Out{Dest: &times})
+	cancel()
+	if err != nil {
+		t.Fatal("update error:", err)
+	}
+
+	if len(ids) != 2 || len(times) != 2 {
+		t.Fatalf("update returned: %v, %v - expected 2 rows", ids, times)
+	}
+	if !((ids[0] == 20 && ids[1] == 30) || (ids[0] == 30 && ids[1] == 20)) {
+		t.Errorf("ids: received: %v - expected: %v", ids, []int64{20, 30})
+	}
+	if !times[0].Equal(aTime) || !times[1].Equal(aTime) {
+		t.Errorf("times: received: %v - expected: %v", times, aTime)
+	}
+
+	// delete returning no rows
+	ctx, cancel = context.WithTimeout(context.Background(), TestContextTimeout)
+	_, err = TestDB.ExecContext(ctx, "delete from "+tableName+" where A < 0 returning A into :1", sql.Out{Dest: &ids})
+	cancel()
+	if err != nil {
+		t.Fatal("delete error:", err)
+	}
+	if len(ids) != 0 {
+		t.Errorf("ids: received: %v - expected: none", ids)
+	}
+}
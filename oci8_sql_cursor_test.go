@@ -0,0 +1,150 @@
+package oci8
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"testing"
+)
+
+// TestDestructiveRefCursorOut tests REF CURSOR out binds
+func TestDestructiveRefCursorOut(t *testing.T) {
+	if TestDisableDatabase || TestDisableDestructive {
+		t.SkipNow()
+	}
+
+	procedureName := "P_CURSOR_" + TestTimeString
+	err := testExec(t, `create or replace procedure `+procedureName+`(p_max in number, p_cursor out SYS_REFCURSOR)
+is
+begin
+	open p_cursor for select level, 'row ' || level from dual connect by level <= p_max;
+end `+procedureName+`;`, nil)
+	if err != nil {
+		t.Fatal("create procedure error:", err)
+	}
+	defer testExecQuery(t, "drop procedure "+procedureName, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), TestContextTimeout)
+	defer cancel()
+
+	conn, err := TestDB.Conn(ctx)
+	if err != nil {
+		t.Fatal("conn error:", err)
+	}
+	defer conn.Close()
+
+	var rows driver.Rows
+	_, err = conn.ExecContext(ctx, "begin "+procedureName+"(:1, :2); end;", 3, sql.Out{Dest: &rows})
+	if err != nil {
+		t.Fatal("exec error:", err)
+	}
+	if rows == nil {
+		t.Fatal("rows is nil")
+	}
+
+	columns := rows.Columns()
+	if len(columns) != 2 {
+		t.Fatalf("columns: received: %v - expected: %v", len(columns), 2)
+	}
+
+	dest := make([]driver.Value, len(columns))
+	var count float64
+	for {
+		err = rows.Next(dest)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			rows.Close()
+			t.Fatal("next error:", err)
+		}
+		count++
+		if dest[0] != count {
+			t.Errorf("column 0: received: %v - expected: %v", dest[0], count)
+		}
+		if dest[1] != fmt.Sprintf("row %v", count) {
+			t.Errorf("column 1: received: %v - expected: row %v", dest[1], count)
+		}
+	}
+	if count != 3 {
+		t.Errorf("rows: received: %v - expected: %v", count, 3)
+	}
+
+	err = rows.Close()
+	if err != nil {
+		t.Fatal("rows close error:", err)
+	}
+
+	// in cursor is not supported
+	_, err = conn.ExecContext(ctx, "begin "+procedureName+"(:1, :2); end;", 3, sql.Out{Dest: &rows, In: true})
+	if err == nil {
+		t.Fatal("expected error for in ref cursor")
+	}
+}
+
+// TestImplicitResults tests iterating implicit result sets returned with DBMS_SQL.RETURN_RESULT
+func TestImplicitResults(t *testing.T) {
+	if TestDisableDatabase {
+		t.SkipNow()
+	}
+
+	query := `declare
+	l_cursor1 SYS_REFCURSOR;
+	l_cursor2 SYS_REFCURSOR;
+begin
+	open l_cursor1 for select 'a' from dual union all select 'b' from dual;
+	dbms_sql.return_result(l_cursor1);
+	open l_cursor2 for select 1, 2 from dual;
+	dbms_sql.return_result(l_cursor2);
+end;`
+
+	ctx, cancel := context.WithTimeout(context.Background(), TestContextTimeout)
+	defer cancel()
+
+	rows, err := TestDB.QueryContext(ctx, query)
+	if err != nil {
+		t.Fatal("query error:", err)
+	}
+	defer rows.Close()
+
+	var results [][]string
+	for {
+		var columns []string
+		columns, err = rows.Columns()
+		if err != nil {
+			t.Fatal("columns error:", err)
+		}
+
+		var result []string
+		for rows.Next() {
+			values := make([]sql.NullString, len(columns))
+			dest := make([]interface{}, len(columns))
+			for i := range values {
+				dest[i] = &values[i]
+			}
+			err = rows.Scan(dest...)
+			if err != nil {
+				t.Fatal("scan error:", err)
+			}
+			for i := range values {
+				result = append(result, values[i].String)
+			}
+		}
+		results = append(results, result)
+
+		if !rows.NextResultSet() {
+			break
+		}
+	}
+	err = rows.Err()
+	if err != nil {
+		t.Fatal("rows error:", err)
+	}
+
+	expected := [][]string{{"a", "b"}, {"1", "2"}}
+	if fmt.Sprint(results) != fmt.Sprint(expected) {
+		t.Fatalf("results: received: %v - expected: %v", results, expected)
+	}
+}
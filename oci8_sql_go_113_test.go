@@ -4,7 +4,10 @@ package oci8
 
 import (
 	"context"
+	"database/sql"
+	"strings"
 	"testing"
+	"time"
 )
 
 // TestStatementCaching tests to ensure statement caching is working
@@ -82,3 +85,202 @@ func TestStatementCaching(t *testing.T) {
 		t.Fatal("stmt close error:", err)
 	}
 }
+
+// TestOutBindTypes tests time, interval, and LOB out binds
+func TestOutBindTypes(t *testing.T) {
+	if TestDisableDatabase {
+		t.SkipNow()
+	}
+
+	aTime := time.Date(2006, 1, 2, 15, 4, 5, 123456000, time.FixedZone("", -7*3600))
+	var outTime time.Time
+	var nullTime sql.NullTime
+	var validNullTime sql.NullTime
+	duration := 26*time.Hour + 3*time.Minute + 4*time.Second + 5000
+	var clob Clob
+	var blob Blob
+
+	ctx, cancel := context.WithTimeout(context.Background(), TestContextTimeout)
+	_, err := TestDB.ExecContext(ctx, `declare
+	l_clob CLOB;
+	l_blob BLOB;
+begin
+	:1 := :2;
+	:3 := null;
+	:4 := :2;
+	:5 := :5 + :5;
+	dbms_lob.createtemporary(l_clob, true);
+	dbms_lob.createtemporary(l_blob, true);
+	for i in 1 .. 5 loop
+		dbms_lob.append(l_clob, rpad('a', 10000, 'a'));
+		dbms_lob.append(l_blob, utl_raw.copies(hextoraw('01'), 10000));
+	end loop;
+	:6 := l_clob;
+	:7 := l_blob;
+end;`, sql.Out{Dest: &outTime}, aTime, sql.Out{Dest: &nullTime}, sql.Out{Dest: &validNullTime},
+		sql.Out{Dest: &duration, In: true}, sql.Out{Dest: &clob}, sql.Out{Dest: &blob})
+	cancel()
+	if err != nil {
+		t.Fatal("exec error:", err)
+	}
+
+	if !outTime.Equal(aTime) {
+		t.Errorf("time: received: %v - expected: %v", outTime, aTime)
+	}
+	if nullTime.Valid {
+		t.Errorf("null time: received: %v - expected: null", nullTime.Time)
+	}
+	if !validNullTime.Valid || !validNullTime.Time.Equal(aTime) {
+		t.Errorf("null time: received: %v - expected: %v", validNullTime, aTime)
+	}
+	if duration != 2*(26*time.Hour+3*time.Minute+4*time.Second+5000) {
+		t.Errorf("duration: received: %v", duration)
+	}
+	if string(clob) != strings.Repeat("a", 50000) {
+		t.Errorf("clob: received length: %v - expected length: %v", len(clob), 50000)
+	}
+	if len(blob) != 50000 || blob[0] != 1 || blob[49999] != 1 {
+		t.Errorf("blob: received length: %v - expected length: %v", len(blob), 50000)
+	}
+
+	// unsupported destination
+	var unsupported struct{}
+	ctx, cancel = context.WithTimeout(context.Background(), TestContextTimeout)
+	_, err = TestDB.ExecContext(ctx, "begin :1 := 1; end;", sql.Out{Dest: &unsupported})
+	cancel()
+	if err == nil {
+		t.Fatal("expected error for unsupported destination")
+	}
+}
+
+// TestNativeBool tests binding bool as the native PL/SQL BOOLEAN type with native_bool
+func TestNativeBool(t *testing.T) {
+	if TestDisableDatabase {
+		t.SkipNow()
+	}
+
+	t.Parallel()
+
+	db := testGetDB("?native_bool=true")
+	if db == nil {
+		t.Fatal("db is null")
+	}
+	defer db.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), TestContextTimeout)
+	conn, err := db.Conn(ctx)
+	cancel()
+	if err != nil {
+		t.Fatal("conn error:", err)
+	}
+	defer conn.Close()
+
+	var nativeBool bool
+	err = conn.Raw(func(driverConn interface{}) error {
+		oci8Conn := driverConn.(*Conn)
+		nativeBool = oci8Conn.clientVersion >= 12 && oci8Conn.serverVersion >= 12
+		return nil
+	})
+	if err != nil {
+		t.Fatal("raw error:", err)
+	}
+	if !nativeBool {
+		t.Skip("client or server does not support native BOOLEAN binds")
+	}
+
+	query := `
+declare
+	function NOT_BOOL(p_bool BOOLEAN) return BOOLEAN as
+	begin
+		return not p_bool;
+	end NOT_BOOL;
+begin
+	:bool2 := NOT_BOOL(:bool1);
+end;`
+
+	for _, value := range []bool{true, false} {
+		var result sql.NullBool
+		ctx, cancel = context.WithTimeout(context.Background(), TestContextTimeout)
+		_, err = conn.ExecContext(ctx, query, sql.Named("bool2", sql.Out{Dest: &result}), sql.Named("bool1", value))
+		cancel()
+		if err != nil {
+			t.Fatal("exec error:", err)
+		}
+		if !result.Valid || result.Bool != !value {
+			t.Errorf("result: received: %v - expected: %v", result, !value)
+		}
+	}
+}
+
+// TestDestructiveNativeBoolArray tests binding bool slices to PL/SQL BOOLEAN associative arrays with native_bool
+func TestDestructiveNativeBoolArray(t *testing.T) {
+	if TestDisableDatabase || TestDisableDestructive {
+		t.SkipNow()
+	}
+
+	db := testGetDB("?native_bool=true")
+	if db == nil {
+		t.Fatal("db is null")
+	}
+	defer db.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), TestContextTimeout)
+	conn, err := db.Conn(ctx)
+	cancel()
+	if err != nil {
+		t.Fatal("conn error:", err)
+	}
+	defer conn.Close()
+
+	var nativeBool bool
+	err = conn.Raw(func(driverConn interface{}) error {
+		oci8Conn := driverConn.(*Conn)
+		nativeBool = oci8Conn.clientVersion >= 12 && oci8Conn.serverVersion >= 12
+		return nil
+	})
+	if err != nil {
+		t.Fatal("raw error:", err)
+	}
+	if !nativeBool {
+		t.Skip("client or server does not support native BOOLEAN binds")
+	}
+
+	packageName := "PKG_BOOLS_" + TestTimeString
+	err = testExec(t, `create or replace package `+packageName+` is
+	type t_bools is table of boolean index by pls_integer;
+	procedure not_bools(p_in in t_bools, p_out out t_bools);
+end `+packageName+`;`, nil)
+	if err != nil {
+		t.Fatal("create package error:", err)
+	}
+
+	defer func() {
+		err := testExec(t, "drop package "+packageName, nil)
+		if err != nil {
+			t.Error("drop package error:", err)
+		}
+	}()
+
+	err = testExec(t, `create or replace package body `+packageName+` is
+	procedure not_bools(p_in in t_bools, p_out out t_bools) is
+	begin
+		for i in 1 .. p_in.count loop
+			p_out(i) := not p_in(i);
+		end loop;
+	end not_bools;
+end `+packageName+`;`, nil)
+	if err != nil {
+		t.Fatal("create package body error:", err)
+	}
+
+	result := make([]bool, 0, 3)
+	ctx, cancel = context.WithTimeout(context.Background(), TestContextTimeout)
+	_, err = conn.ExecContext(ctx, "begin "+packageName+".not_bools(:1, :2); end;", []bool{true, false, true}, sql.Out{Dest: &result})
+	cancel()
+	if err != nil {
+		t.Fatal("exec error:", err)
+	}
+	if len(result) != 3 || result[0] || !result[1] || result[2] {
+		t.Errorf("result: received: %v - expected: %v", result, []bool{false, true, false})
+	}
+}
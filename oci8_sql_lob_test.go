@@ -0,0 +1,353 @@
+package oci8
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+// TestDestructiveLob checks binding a Lob from an io.Reader, and reading and writing Lob locators
+func TestDestructiveLob(t *testing.T) {
+	if TestDisableDatabase || TestDisableDestructive {
+		t.SkipNow()
+	}
+
+	tableName := "LOB_" + TestTimeString
+	err := testExec(t, "create table "+tableName+" ( A INTEGER, B BLOB, C CLOB )", nil)
+	if err != nil {
+		t.Fatal("create table error:", err)
+	}
+
+	defer testDropTable(t, tableName)
+
+	blob := bytes.Repeat([]byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, 10000)
+	clob := strings.Repeat("abcé世", 20000)
+
+	ctx, cancel := context.WithTimeout(context.Background(), TestContextTimeout)
+	defer cancel()
+
+	// bind from io.Reader
+	_, err = TestDB.ExecContext(ctx, "insert into "+tableName+" ( A, B, C ) values (1, :1, :2)",
+		NewBlob(bytes.NewReader(blob)), NewClob(strings.NewReader(clob)))
+	if err != nil {
+		t.Fatal("insert error:", err)
+	}
+
+	// the reader is not read when a later bind fails
+	reader := &testReadCountReader{reader: strings.NewReader(clob)}
+	_, err = TestDB.ExecContext(ctx, "insert into "+tableName+" ( A, C ) values (:1, :2)", make(chan int), NewClob(reader))
+	if err == nil {
+		t.Error("insert with bad bind: expected error")
+	}
+	if reader.reads != 0 {
+		t.Errorf("insert with bad bind: reader read %v times - expected: 0", reader.reads)
+	}
+
+	// a query can read the temporary LOB of a bind while fetching
+	var clobString string
+	err = TestDB.QueryRowContext(ctx, "select to_char(dbms_lob.substr(:1, 10, 1)) from dual", NewClob(strings.NewReader(clob))).Scan(&clobString)
+	if err != nil {
+		t.Fatal("select bind error:", err)
+	}
+	if expected := string([]rune(clob)[:10]); clobString != expected {
+		t.Errorf("select bind: received: %q - expected: %q", clobString, expected)
+	}
+
+	tx, err := TestDB.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatal("begin error:", err)
+	}
+	defer tx.Rollback()
+
+	// scan locators
+	var blobLob, clobLob Lob
+	err = tx.QueryRowContext(ctx, "select B, C from "+tableName+" where A = 1 for update", LobLocator()).Scan(&blobLob, &clobLob)
+	if err != nil {
+		t.Fatal("query error:", err)
+	}
+	defer blobLob.Close()
+	defer clobLob.Close()
+
+	if blobLob.IsClob() || !clobLob.IsClob() {
+		t.Errorf("IsClob: received: %v, %v - expected: false, true", blobLob.IsClob(), clobLob.IsClob())
+	}
+	size, err := blobLob.Size()
+	if err != nil || size != int64(len(blob)) {
+		t.Errorf("BLOB size: received: %v, %v - expected: %v", size, err, len(blob))
+	}
+	size, err = clobLob.Size()
+	if err != nil || size != int64(len([]rune(clob))) {
+		t.Errorf("CLOB size: received: %v, %v - expected: %v", size, err, len([]rune(clob)))
+	}
+	chunkSize, err := blobLob.ChunkSize()
+	if err != nil || chunkSize < 1 {
+		t.Errorf("chunk size: received: %v, %v", chunkSize, err)
+	}
+
+	data, err := ioutil.ReadAll(&blobLob)
+	if err != nil || !bytes.Equal(data, blob) {
+		t.Errorf("BLOB read: received: %v bytes, %v - expected: %v bytes", len(data), err, len(blob))
+	}
+	data, err = ioutil.ReadAll(&clobLob)
+	if err != nil || string(data) != clob {
+		t.Errorf("CLOB read: received: %v bytes, %v - expected: %v bytes", len(data), err, len(clob))
+	}
+
+	buffer := make([]byte, 5)
+	n, err := blobLob.ReadAt(buffer, 3)
+	if err != nil || !bytes.Equal(buffer[:n], []byte{3, 4, 5, 6, 7}) {
+		t.Errorf("BLOB read at: received: %v, %v", buffer[:n], err)
+	}
+
+	// write, seek, and trim in a batch
+	err = blobLob.Open()
+	if err != nil {
+		t.Fatal("open error:", err)
+	}
+	_, err = blobLob.Seek(-2, io.SeekEnd)
+	if err != nil {
+		t.Fatal("seek error:", err)
+	}
+	_, err = blobLob.Write([]byte{10, 11, 12})
+	if err != nil {
+		t.Fatal("write error:", err)
+	}
+	_, err = blobLob.WriteAt([]byte{20}, 0)
+	if err != nil {
+		t.Fatal("write at error:", err)
+	}
+	err = clobLob.Trim(3)
+	if err != nil {
+		t.Fatal("trim error:", err)
+	}
+	err = blobLob.Close()
+	if err != nil {
+		t.Fatal("close error:", err)
+	}
+
+	var blobValue []byte
+	var clobValue string
+	err = tx.QueryRowContext(ctx, "select B, C from "+tableName+" where A = 1").Scan(&blobValue, &clobValue)
+	if err != nil {
+		t.Fatal("query error:", err)
+	}
+	expected := append(append([]byte{20}, blob[1:len(blob)-2]...), 10, 11, 12)
+	if !bytes.Equal(blobValue, expected) {
+		t.Errorf("BLOB: received: %v bytes - expected: %v bytes", len(blobValue), len(expected))
+	}
+	if clobValue != "abc" {
+		t.Errorf("CLOB: received: %q - expected: %q", clobValue, "abc")
+	}
+
+	// out bind locators from returning
+	outBlob := NewBlob(nil)
+	outClob := NewClob(nil)
+	_, err = tx.ExecContext(ctx, "insert into "+tableName+" ( A, B, C ) values (2, empty_blob(), empty_clob()) returning B, C into :1, :2",
+		sql.Out{Dest: outBlob}, sql.Out{Dest: outClob})
+	if err != nil {
+		t.Fatal("insert error:", err)
+	}
+	defer outBlob.Close()
+	defer outClob.Close()
+
+	_, err = io.Copy(outBlob, bytes.NewReader(blob))
+	if err != nil {
+		t.Fatal("copy error:", err)
+	}
+	_, err = io.Copy(outClob, strings.NewReader(clob))
+	if err != nil {
+		t.Fatal("copy error:", err)
+	}
+
+	err = tx.QueryRowContext(ctx, "select B, C from "+tableName+" where A = 2").Scan(&blobValue, &clobValue)
+	if err != nil {
+		t.Fatal("query error:", err)
+	}
+	if !bytes.Equal(blobValue, blob) {
+		t.Errorf("BLOB: received: %v bytes - expected: %v bytes", len(blobValue), len(blob))
+	}
+	if clobValue != clob {
+		t.Errorf("CLOB: received: %v bytes - expected: %v bytes", len(clobValue), len(clob))
+	}
+}
+
+// TestLobFetch checks the lob_fetch and lob_prefetch_size DSN parameters and the LobPrefetchSize option
+func TestLobFetch(t *testing.T) {
+	if TestDisableDatabase {
+		t.SkipNow()
+	}
+
+	t.Parallel()
+
+	db := testGetDB("?lob_fetch=locator&lob_prefetch_size=4000")
+	if db == nil {
+		t.Fatal("db is null")
+	}
+	defer db.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), TestContextTimeout)
+	defer cancel()
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		t.Fatal("conn error:", err)
+	}
+	defer conn.Close()
+
+	rows, err := conn.QueryContext(ctx, "select to_clob('abc'), to_blob(hextoraw('0102')) from dual")
+	if err != nil {
+		t.Fatal("query error:", err)
+	}
+	if !rows.Next() {
+		rows.Close()
+		t.Fatal("no rows:", rows.Err())
+	}
+	var clobValue, blobValue interface{}
+	err = rows.Scan(&clobValue, &blobValue)
+	if err != nil {
+		rows.Close()
+		t.Fatal("scan error:", err)
+	}
+	clobLob, ok := clobValue.(*Lob)
+	if !ok {
+		rows.Close()
+		t.Fatalf("CLOB: received: %T - expected: *Lob", clobValue)
+	}
+	data, err := ioutil.ReadAll(clobLob)
+	if err != nil || string(data) != "abc" {
+		t.Errorf("CLOB read: received: %q, %v - expected: %q", data, err, "abc")
+	}
+	blobLob, ok := blobValue.(*Lob)
+	if !ok {
+		rows.Close()
+		t.Fatalf("BLOB: received: %T - expected: *Lob", blobValue)
+	}
+	data, err = ioutil.ReadAll(blobLob)
+	if err != nil || !bytes.Equal(data, []byte{1, 2}) {
+		t.Errorf("BLOB read: received: %v, %v - expected: %v", data, err, []byte{1, 2})
+	}
+	err = rows.Close()
+	if err != nil {
+		t.Fatal("close error:", err)
+	}
+
+	// the rows close the Lobs they returned
+	_, err = clobLob.Read(make([]byte, 10))
+	if err == nil {
+		t.Error("read after rows close: expected error")
+	}
+
+	// a Lob scanned into stays valid after the rows are closed
+	var lob Lob
+	err = conn.QueryRowContext(ctx, "select to_clob('abc') from dual").Scan(&lob)
+	if err != nil {
+		t.Fatal("query error:", err)
+	}
+	data, err = ioutil.ReadAll(&lob)
+	if err != nil || string(data) != "abc" {
+		t.Errorf("scanned CLOB read: received: %q, %v - expected: %q", data, err, "abc")
+	}
+	err = lob.Close()
+	if err != nil {
+		t.Error("close error:", err)
+	}
+
+	// the LobPrefetchSize option in data mode
+	var clobString string
+	err = TestDB.QueryRowContext(ctx, "select to_clob('abc') from dual", LobPrefetchSize(100)).Scan(&clobString)
+	if err != nil {
+		t.Fatal("query error:", err)
+	}
+	if clobString != "abc" {
+		t.Errorf("CLOB: received: %q - expected: %q", clobString, "abc")
+	}
+}
+
+// TestDestructiveBFile checks binding a BFile and fetching BFILE columns
+func TestDestructiveBFile(t *testing.T) {
+	if TestDisableDatabase || TestDisableDestructive {
+		t.SkipNow()
+	}
+
+	tableName := "BFILE_" + TestTimeString
+	err := testExec(t, "create table "+tableName+" ( A INTEGER, B BFILE )", nil)
+	if err != nil {
+		t.Fatal("create table error:", err)
+	}
+
+	defer testDropTable(t, tableName)
+
+	ctx, cancel := context.WithTimeout(context.Background(), TestContextTimeout)
+	defer cancel()
+
+	_, err = TestDB.ExecContext(ctx, "insert into "+tableName+" ( A, B ) values (1, :1)", NewBFile("NO_SUCH_DIR", "no_such_file.txt"))
+	if err != nil {
+		t.Fatal("insert error:", err)
+	}
+	_, err = TestDB.ExecContext(ctx, "insert into "+tableName+" ( A, B ) values (2, null)")
+	if err != nil {
+		t.Fatal("insert error:", err)
+	}
+
+	rows, err := TestDB.QueryContext(ctx, "select B from "+tableName+" order by A")
+	if err != nil {
+		t.Fatal("query error:", err)
+	}
+	defer rows.Close()
+
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		t.Fatal("column types error:", err)
+	}
+	if columnTypes[0].ScanType() != typeBFile {
+		t.Errorf("scan type: received: %v - expected: %v", columnTypes[0].ScanType(), typeBFile)
+	}
+
+	if !rows.Next() {
+		t.Fatal("no rows:", rows.Err())
+	}
+	var bfile BFile
+	err = rows.Scan(&bfile)
+	if err != nil {
+		t.Fatal("scan error:", err)
+	}
+	defer bfile.Close()
+	if bfile.Directory() != "NO_SUCH_DIR" || bfile.Filename() != "no_such_file.txt" {
+		t.Errorf("BFile: received: %v, %v - expected: NO_SUCH_DIR, no_such_file.txt", bfile.Directory(), bfile.Filename())
+	}
+	_, err = bfile.Exists()
+	if err == nil {
+		t.Error("Exists: expected error for a directory that does not exist")
+	}
+	_, err = bfile.Read(make([]byte, 10))
+	if err == nil {
+		t.Error("Read: expected error for a directory that does not exist")
+	}
+
+	if !rows.Next() {
+		t.Fatal("no rows:", rows.Err())
+	}
+	var value interface{}
+	err = rows.Scan(&value)
+	if err != nil {
+		t.Fatal("scan error:", err)
+	}
+	if value != nil {
+		t.Errorf("null BFILE: received: %v - expected: nil", value)
+	}
+}
+
+// testReadCountReader counts the Read calls of reader
+type testReadCountReader struct {
+	reader io.Reader
+	reads  int
+}
+
+func (r *testReadCountReader) Read(p []byte) (int, error) {
+	r.reads++
+	return r.reader.Read(p)
+}
@@ -5,6 +5,8 @@ import (
 	"database/sql"
 	"fmt"
 	"math"
+	"math/big"
+	"reflect"
 	"sync"
 	"testing"
 )
@@ -3858,3 +3860,149 @@ func TestDestructiveNumberColumnTypes(t *testing.T) {
 	}
 
 }
+
+// TestExactNumber tests fetching and binding Number
+func TestExactNumber(t *testing.T) {
+	if TestDisableDatabase {
+		t.SkipNow()
+	}
+
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), TestContextTimeout)
+	defer cancel()
+
+	var money, sum, integer interface{}
+	err := TestDB.QueryRowContext(ctx, "select cast(12345678901234567.89 as NUMBER(20,2)), 0.1 + 0.2, cast(7 as NUMBER(10)) from dual", ExactNumber()).
+		Scan(&money, &sum, &integer)
+	if err != nil {
+		t.Fatal("scan error:", err)
+	}
+	if money != Number("12345678901234567.89") {
+		t.Errorf("money: received: %#v - expected: %#v", money, Number("12345678901234567.89"))
+	}
+	if sum != Number("0.3") {
+		t.Errorf("sum: received: %#v - expected: %#v", sum, Number("0.3"))
+	}
+	if integer != int64(7) {
+		t.Errorf("integer: received: %#v - expected: %#v", integer, int64(7))
+	}
+
+	// without the option numbers are float64
+	err = TestDB.QueryRowContext(ctx, "select 0.1 + 0.2 from dual").Scan(&sum)
+	if err != nil {
+		t.Fatal("scan error:", err)
+	}
+	if _, ok := sum.(float64); !ok {
+		t.Errorf("sum: received: %T - expected: float64", sum)
+	}
+
+	// in and out binds
+	var result Number
+	_, err = TestDB.ExecContext(ctx, "begin :1 := :2 * 10; end;", sql.Out{Dest: &result}, Number("-98765432109876543210.123"))
+	if err != nil {
+		t.Fatal("exec error:", err)
+	}
+	if result != "-987654321098765432101.23" {
+		t.Errorf("result: received: %v - expected: %v", result, "-987654321098765432101.23")
+	}
+}
+
+// TestLargeIntegers tests binding and fetching integers that do not fit in an int64
+func TestLargeIntegers(t *testing.T) {
+	if TestDisableDatabase {
+		t.SkipNow()
+	}
+
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), TestContextTimeout)
+	defer cancel()
+
+	bigInt, _ := new(big.Int).SetString("-12345678901234567890123456789012345678", 10)
+	var maxUint64, number38, small interface{}
+	err := TestDB.QueryRowContext(ctx, "select cast(:1 as NUMBER(20)), cast(:2 as NUMBER(38)), cast(1 as NUMBER(38)) from dual",
+		uint64(math.MaxUint64), bigInt).Scan(&maxUint64, &number38, &small)
+	if err != nil {
+		t.Fatal("scan error:", err)
+	}
+	if maxUint64 != Number("18446744073709551615") {
+		t.Errorf("max uint64: received: %#v - expected: %#v", maxUint64, Number("18446744073709551615"))
+	}
+	if number38 != Number(bigInt.String()) {
+		t.Errorf("number38: received: %#v - expected: %#v", number38, Number(bigInt.String()))
+	}
+	if small != int64(1) {
+		t.Errorf("small: received: %#v - expected: %#v", small, int64(1))
+	}
+
+	// NUMBER without precision is float64 unless ExactNumber is set, NUMBER(38) is int64 when it fits
+	query := "select to_number('1.5'), to_number('123456789012345678901234567890'), cast(1 as NUMBER(38)) from dual"
+	rows, err := TestDB.QueryContext(ctx, query)
+	if err != nil {
+		t.Fatal("query error:", err)
+	}
+	defer rows.Close()
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		t.Fatal("column types error:", err)
+	}
+	expectedTypes := []reflect.Type{typeFloat64, typeFloat64, typeInt64}
+	for i := range expectedTypes {
+		if columnTypes[i].ScanType() != expectedTypes[i] {
+			t.Errorf("scan type %v: received: %v - expected: %v", i, columnTypes[i].ScanType(), expectedTypes[i])
+		}
+	}
+	rows.Close()
+
+	var float, wide, number38Small interface{}
+	err = TestDB.QueryRowContext(ctx, query).Scan(&float, &wide, &number38Small)
+	if err != nil {
+		t.Fatal("scan error:", err)
+	}
+	if float != float64(1.5) {
+		t.Errorf("float: received: %#v - expected: %#v", float, float64(1.5))
+	}
+	if wide != float64(123456789012345678901234567890) {
+		t.Errorf("wide: received: %#v - expected: %#v", wide, float64(123456789012345678901234567890))
+	}
+	if number38Small != int64(1) {
+		t.Errorf("number38 small: received: %#v - expected: %#v", number38Small, int64(1))
+	}
+
+	err = TestDB.QueryRowContext(ctx, query, ExactNumber()).Scan(&float, &wide, &number38Small)
+	if err != nil {
+		t.Fatal("scan error:", err)
+	}
+	if float != Number("1.5") {
+		t.Errorf("exact float: received: %#v - expected: %#v", float, Number("1.5"))
+	}
+	if wide != Number("123456789012345678901234567890") {
+		t.Errorf("exact wide: received: %#v - expected: %#v", wide, Number("123456789012345678901234567890"))
+	}
+
+	var uint64Value uint64
+	err = TestDB.QueryRowContext(ctx, "select cast(:1 as NUMBER(20)) from dual", uint64(math.MaxUint64)).Scan(&uint64Value)
+	if err != nil {
+		t.Fatal("scan error:", err)
+	}
+	if uint64Value != math.MaxUint64 {
+		t.Errorf("uint64: received: %v - expected: %v", uint64Value, uint64(math.MaxUint64))
+	}
+
+	// out binds
+	outUint64 := uint64(math.MaxUint64 - 1)
+	outBigInt := new(big.Int)
+	_, err = TestDB.ExecContext(ctx, "begin :1 := :1 + 1; :2 := :3 * 10; end;",
+		sql.Out{Dest: &outUint64, In: true}, sql.Out{Dest: outBigInt}, bigInt)
+	if err != nil {
+		t.Fatal("exec error:", err)
+	}
+	if outUint64 != math.MaxUint64 {
+		t.Errorf("out uint64: received: %v - expected: %v", outUint64, uint64(math.MaxUint64))
+	}
+	expected := new(big.Int).Mul(bigInt, big.NewInt(10))
+	if outBigInt.Cmp(expected) != 0 {
+		t.Errorf("out big.Int: received: %v - expected: %v", outBigInt, expected)
+	}
+}
@@ -0,0 +1,264 @@
+package oci8
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestDestructiveObjects tests selecting and binding object types and collections
+func TestDestructiveObjects(t *testing.T) {
+	if TestDisableDatabase || TestDisableDestructive {
+		t.SkipNow()
+	}
+
+	pointType := "POINT_" + TestTimeString
+	lineType := "LINE_" + TestTimeString
+	numbersType := "NUMBERS_" + TestTimeString
+	tableName := "OBJECTS_" + TestTimeString
+
+	err := testExec(t, "create type "+pointType+" as object ( X NUMBER(10), Y NUMBER(10), LABEL VARCHAR2(100) )", nil)
+	if err != nil {
+		t.Fatal("create type error:", err)
+	}
+	defer testExecQuery(t, "drop type "+pointType, nil)
+
+	err = testExec(t, "create type "+lineType+" as object ( START_POINT "+pointType+", END_POINT "+pointType+" )", nil)
+	if err != nil {
+		t.Fatal("create type error:", err)
+	}
+	defer testExecQuery(t, "drop type "+lineType, nil)
+
+	err = testExec(t, "create type "+numbersType+" as table of NUMBER(10)", nil)
+	if err != nil {
+		t.Fatal("create type error:", err)
+	}
+	defer testExecQuery(t, "drop type "+numbersType, nil)
+
+	err = testExec(t, "create table "+tableName+" ( ID INTEGER, LINE "+lineType+" )", nil)
+	if err != nil {
+		t.Fatal("create table error:", err)
+	}
+	defer testDropTable(t, tableName)
+
+	line := &Object{
+		TypeName: lineType,
+		Attributes: map[string]interface{}{
+			"START_POINT": &Object{TypeName: pointType, Attributes: map[string]interface{}{"X": int64(1), "Y": int64(2), "LABEL": "start"}},
+			"END_POINT":   &Object{TypeName: pointType, Attributes: map[string]interface{}{"X": int64(3), "Y": int64(4), "LABEL": nil}},
+		},
+	}
+
+	// insert object binds
+	err = testExec(t, "insert into "+tableName+" ( ID, LINE ) values (:1, :2)", []interface{}{1, line})
+	if err != nil {
+		t.Fatal("insert error:", err)
+	}
+	err = testExec(t, "insert into "+tableName+" ( ID, LINE ) values (:1, :2)", []interface{}{2, &Object{TypeName: lineType}})
+	if err != nil {
+		t.Fatal("insert error:", err)
+	}
+
+	// select objects
+	ctx, cancel := context.WithTimeout(context.Background(), TestContextTimeout)
+	rows, err := TestDB.QueryContext(ctx, "select LINE from "+tableName+" order by ID")
+	if err != nil {
+		cancel()
+		t.Fatal("query error:", err)
+	}
+	var results []Object
+	for rows.Next() {
+		var object Object
+		err = rows.Scan(&object)
+		if err != nil {
+			t.Error("scan error:", err)
+		}
+		results = append(results, object)
+	}
+	err = rows.Err()
+	if err != nil {
+		t.Error("rows error:", err)
+	}
+	rows.Close()
+	cancel()
+
+	if len(results) != 2 {
+		t.Fatalf("rows: received: %v - expected: %v", len(results), 2)
+	}
+	if !strings.HasSuffix(results[0].TypeName, "."+lineType) {
+		t.Errorf("type name: received: %v - expected: %v", results[0].TypeName, lineType)
+	}
+	startPoint, ok := results[0].Attributes["START_POINT"].(*Object)
+	if !ok {
+		t.Fatalf("START_POINT: received: %T - expected: %T", results[0].Attributes["START_POINT"], startPoint)
+	}
+	expectedAttributes := map[string]interface{}{"X": int64(1), "Y": int64(2), "LABEL": "start"}
+	if !reflect.DeepEqual(startPoint.Attributes, expectedAttributes) {
+		t.Errorf("START_POINT: received: %v - expected: %v", startPoint.Attributes, expectedAttributes)
+	}
+	endPoint, ok := results[0].Attributes["END_POINT"].(*Object)
+	if !ok {
+		t.Fatalf("END_POINT: received: %T - expected: %T", results[0].Attributes["END_POINT"], endPoint)
+	}
+	expectedAttributes = map[string]interface{}{"X": int64(3), "Y": int64(4), "LABEL": nil}
+	if !reflect.DeepEqual(endPoint.Attributes, expectedAttributes) {
+		t.Errorf("END_POINT: received: %v - expected: %v", endPoint.Attributes, expectedAttributes)
+	}
+	if results[1].Attributes != nil {
+		t.Errorf("null object: received: %v - expected: nil", results[1].Attributes)
+	}
+
+	// PL/SQL in out object and collection binds
+	point := Object{TypeName: pointType, Attributes: map[string]interface{}{"X": int64(5), "Y": int64(6), "LABEL": "a"}}
+	numbers := Collection{TypeName: numbersType}
+	ctx, cancel = context.WithTimeout(context.Background(), TestContextTimeout)
+	_, err = TestDB.ExecContext(ctx, "begin :1.X := :1.X * 10; :1.LABEL := :1.LABEL || 'b'; :2 := "+numbersType+"(1, 2, null, 4); end;",
+		sql.Out{Dest: &point, In: true}, sql.Out{Dest: &numbers})
+	cancel()
+	if err != nil {
+		t.Fatal("exec error:", err)
+	}
+
+	expectedAttributes = map[string]interface{}{"X": int64(50), "Y": int64(6), "LABEL": "ab"}
+	if !reflect.DeepEqual(point.Attributes, expectedAttributes) {
+		t.Errorf("point: received: %v - expected: %v", point.Attributes, expectedAttributes)
+	}
+	expectedElements := []interface{}{int64(1), int64(2), nil, int64(4)}
+	if !reflect.DeepEqual(numbers.Elements, expectedElements) {
+		t.Errorf("numbers: received: %v - expected: %v", numbers.Elements, expectedElements)
+	}
+
+	// collection in bind
+	var count int64
+	ctx, cancel = context.WithTimeout(context.Background(), TestContextTimeout)
+	err = TestDB.QueryRowContext(ctx, "select count(*) from table(:1)", Collection{TypeName: numbersType, Elements: []interface{}{1, 2, 3}}).Scan(&count)
+	cancel()
+	if err != nil {
+		t.Fatal("query row error:", err)
+	}
+	if count != 3 {
+		t.Errorf("count: received: %v - expected: %v", count, 3)
+	}
+
+	// unknown attribute
+	err = testExec(t, "insert into "+tableName+" ( ID, LINE ) values (:1, :2)",
+		[]interface{}{3, &Object{TypeName: lineType, Attributes: map[string]interface{}{"MIDDLE": nil}}})
+	if err == nil {
+		t.Fatal("expected error for unknown attribute")
+	}
+}
+
+// TestDestructiveObjectStructs tests selecting and binding registered struct types
+func TestDestructiveObjectStructs(t *testing.T) {
+	if TestDisableDatabase || TestDisableDestructive {
+		t.SkipNow()
+	}
+
+	type testPoint struct {
+		X     int64  `oci8:"X"`
+		Y     int64  `oci8:"Y"`
+		Label string `oci8:"LABEL"`
+	}
+	type testPolygon struct {
+		Name   string      `oci8:"NAME"`
+		Points []testPoint `oci8:"POINTS"`
+		Center *testPoint  `oci8:"CENTER"`
+	}
+
+	pointType := "S_POINT_" + TestTimeString
+	pointsType := "S_POINTS_" + TestTimeString
+	polygonType := "S_POLYGON_" + TestTimeString
+	tableName := "OBJECT_STRUCTS_" + TestTimeString
+
+	err := testExec(t, "create type "+pointType+" as object ( X NUMBER(10), Y NUMBER(10), LABEL VARCHAR2(100) )", nil)
+	if err != nil {
+		t.Fatal("create type error:", err)
+	}
+	defer testExecQuery(t, "drop type "+pointType, nil)
+
+	err = testExec(t, "create type "+pointsType+" as varray(10) of "+pointType, nil)
+	if err != nil {
+		t.Fatal("create type error:", err)
+	}
+	defer testExecQuery(t, "drop type "+pointsType, nil)
+
+	err = testExec(t, "create type "+polygonType+" as object ( NAME VARCHAR2(100), POINTS "+pointsType+", CENTER "+pointType+" )", nil)
+	if err != nil {
+		t.Fatal("create type error:", err)
+	}
+	defer testExecQuery(t, "drop type "+polygonType, nil)
+
+	err = testExec(t, "create table "+tableName+" ( ID INTEGER, POLYGON "+polygonType+" )", nil)
+	if err != nil {
+		t.Fatal("create table error:", err)
+	}
+	defer testDropTable(t, tableName)
+
+	err = RegisterObjectType(polygonType, testPolygon{})
+	if err != nil {
+		t.Fatal("register error:", err)
+	}
+
+	polygon := testPolygon{
+		Name:   "triangle",
+		Points: []testPoint{{X: 0, Y: 0, Label: "a"}, {X: 4, Y: 0, Label: "b"}, {X: 0, Y: 3, Label: "c"}},
+	}
+
+	// struct bind
+	err = testExec(t, "insert into "+tableName+" ( ID, POLYGON ) values (:1, :2)", []interface{}{1, polygon})
+	if err != nil {
+		t.Fatal("insert error:", err)
+	}
+	err = testExec(t, "insert into "+tableName+" ( ID, POLYGON ) values (:1, :2)", []interface{}{2, (*testPolygon)(nil)})
+	if err != nil {
+		t.Fatal("insert error:", err)
+	}
+
+	// scan into structs
+	ctx, cancel := context.WithTimeout(context.Background(), TestContextTimeout)
+	rows, err := TestDB.QueryContext(ctx, "select POLYGON from "+tableName+" order by ID")
+	if err != nil {
+		cancel()
+		t.Fatal("query error:", err)
+	}
+	var results []*testPolygon
+	for rows.Next() {
+		var result *testPolygon
+		err = rows.Scan(&result)
+		if err != nil {
+			t.Error("scan error:", err)
+		}
+		results = append(results, result)
+	}
+	err = rows.Err()
+	if err != nil {
+		t.Error("rows error:", err)
+	}
+	rows.Close()
+	cancel()
+
+	if len(results) != 2 {
+		t.Fatalf("rows: received: %v - expected: %v", len(results), 2)
+	}
+	if results[0] == nil || !reflect.DeepEqual(*results[0], polygon) {
+		t.Errorf("polygon: received: %+v - expected: %+v", results[0], polygon)
+	}
+	if results[1] != nil {
+		t.Errorf("null polygon: received: %+v - expected: nil", results[1])
+	}
+
+	// PL/SQL in out struct bind
+	ctx, cancel = context.WithTimeout(context.Background(), TestContextTimeout)
+	_, err = TestDB.ExecContext(ctx, "begin :1.CENTER := "+pointType+"(1, 1, 'center'); :1.NAME := upper(:1.NAME); end;",
+		sql.Out{Dest: &polygon, In: true})
+	cancel()
+	if err != nil {
+		t.Fatal("exec error:", err)
+	}
+
+	if polygon.Name != "TRIANGLE" || len(polygon.Points) != 3 || polygon.Center == nil || *polygon.Center != (testPoint{X: 1, Y: 1, Label: "center"}) {
+		t.Errorf("out polygon: received: %+v", polygon)
+	}
+}
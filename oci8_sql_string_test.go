@@ -3408,3 +3408,113 @@ func TestDestructiveStringColumnTypes(t *testing.T) {
 	}
 
 }
+
+// TestOutSize tests the OutSize option and ErrTruncated
+func TestOutSize(t *testing.T) {
+	if TestDisableDatabase {
+		t.SkipNow()
+	}
+
+	t.Parallel()
+
+	query := "begin :string1 := rpad('a', :length, 'a'); :bytes1 := utl_raw.copies(hextoraw('01'), :length); end;"
+
+	var string1 string
+	var bytes1 []byte
+	ctx, cancel := context.WithTimeout(context.Background(), TestContextTimeout)
+	_, err := TestDB.ExecContext(ctx, query, sql.Named("string1", sql.Out{Dest: &string1}), sql.Named("length", 100),
+		sql.Named("bytes1", sql.Out{Dest: &bytes1}), OutSize(100))
+	cancel()
+	if err != nil {
+		t.Fatal("exec error:", err)
+	}
+	if string1 != strings.Repeat("a", 100) {
+		t.Errorf("string1: received: %v - expected: %v", string1, strings.Repeat("a", 100))
+	}
+	if len(bytes1) != 100 {
+		t.Errorf("bytes1: received length: %v - expected length: %v", len(bytes1), 100)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), TestContextTimeout)
+	_, err = TestDB.ExecContext(ctx, "begin :string1 := rpad('a', 100, 'a'); end;",
+		sql.Named("string1", sql.Out{Dest: &string1}), OutSize(10))
+	cancel()
+	errTruncated, ok := err.(*ErrTruncated)
+	if !ok {
+		t.Fatalf("exec error: received: %v - expected: %T", err, errTruncated)
+	}
+	if errTruncated.Param != "string1" || errTruncated.ActualLen != 100 {
+		t.Errorf("ErrTruncated: received: %+v - expected: {Param:string1 ActualLen:100}", *errTruncated)
+	}
+	if string1 != strings.Repeat("a", 10) {
+		t.Errorf("string1: received: %v - expected: %v", string1, strings.Repeat("a", 10))
+	}
+}
+
+// TestDestructiveNString checks binding NString and NCLOB values and fetching NCHAR, NVARCHAR2, and NCLOB columns
+func TestDestructiveNString(t *testing.T) {
+	if TestDisableDatabase || TestDisableDestructive {
+		t.SkipNow()
+	}
+
+	tableName := "NSTRING_" + TestTimeString
+	err := testExec(t, "create table "+tableName+" ( A INTEGER, B NVARCHAR2(100), C NCHAR(10), D NCLOB )", nil)
+	if err != nil {
+		t.Fatal("create table error:", err)
+	}
+
+	defer testDropTable(t, tableName)
+
+	nstring := "abcé世界𝄞"
+	nclob := strings.Repeat(nstring, 5000)
+
+	ctx, cancel := context.WithTimeout(context.Background(), TestContextTimeout)
+	defer cancel()
+
+	_, err = TestDB.ExecContext(ctx, "insert into "+tableName+" ( A, B, C, D ) values (1, :1, :2, :3)",
+		NString(nstring), NString("世界"), NString(nclob))
+	if err != nil {
+		t.Fatal("insert error:", err)
+	}
+	_, err = TestDB.ExecContext(ctx, "insert into "+tableName+" ( A, D ) values (2, :1)", NewNClob(strings.NewReader(nclob)))
+	if err != nil {
+		t.Fatal("insert error:", err)
+	}
+
+	var b, c, d, d2 string
+	err = TestDB.QueryRowContext(ctx, "select B, C, D, (select D from "+tableName+" where A = 2) from "+tableName+" where A = 1").Scan(&b, &c, &d, &d2)
+	if err != nil {
+		t.Fatal("query error:", err)
+	}
+	if b != nstring {
+		t.Errorf("NVARCHAR2: received: %q - expected: %q", b, nstring)
+	}
+	if c != "世界"+strings.Repeat(" ", 8) {
+		t.Errorf("NCHAR: received: %q - expected: %q", c, "世界"+strings.Repeat(" ", 8))
+	}
+	if d != nclob {
+		t.Errorf("NCLOB: received: %v bytes - expected: %v bytes", len(d), len(nclob))
+	}
+	if d2 != nclob {
+		t.Errorf("NCLOB from reader: received: %v bytes - expected: %v bytes", len(d2), len(nclob))
+	}
+
+	var lob Lob
+	err = TestDB.QueryRowContext(ctx, "select D from "+tableName+" where A = 1", LobLocator()).Scan(&lob)
+	if err != nil {
+		t.Fatal("query error:", err)
+	}
+	if !lob.IsNClob() {
+		t.Error("IsNClob: received: false - expected: true")
+	}
+	lob.Close()
+
+	var out NString
+	_, err = TestDB.ExecContext(ctx, "begin select B into :1 from "+tableName+" where A = 1; end;", sql.Out{Dest: &out})
+	if err != nil {
+		t.Fatal("exec error:", err)
+	}
+	if string(out) != nstring {
+		t.Errorf("NString out: received: %q - expected: %q", out, nstring)
+	}
+}
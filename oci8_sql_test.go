@@ -949,20 +949,32 @@ func TestInsertRowid(t *testing.T) {
 		t.Fatal("exec error:", err)
 	}
 
-	var id int64
-	id, err = result.LastInsertId()
-	if err != nil {
+	_, err = result.LastInsertId()
+	if err != ErrNoLastInsertId {
 		stmt.Close()
-		t.Fatal("exec error:", err)
+		t.Fatalf("LastInsertId: received: %v - expected: %v", err, ErrNoLastInsertId)
 	}
 
-	rowids[1] = GetLastInsertId(id)
-
 	err = stmt.Close()
 	if err != nil {
 		t.Fatal("stmt close error", err)
 	}
 
+	// get rowid from ExecRowID
+	ctx, cancel = context.WithTimeout(context.Background(), TestContextTimeout)
+	rowids[1], _, err = ExecRowID(ctx, TestDB, "update "+tableName+" set A = A where A = :1", 1)
+	cancel()
+	if err != nil {
+		t.Fatal("ExecRowID error:", err)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), TestContextTimeout)
+	_, _, err = ExecRowID(ctx, TestDB, "update "+tableName+" set A = A where A = :1", 2)
+	cancel()
+	if err != ErrNoRowid {
+		t.Fatalf("ExecRowID: received: %v - expected: %v", err, ErrNoRowid)
+	}
+
 	// get select rowid
 	query = "select rowid from " + tableName
 	ctx, cancel = context.WithTimeout(context.Background(), TestContextTimeout)
@@ -1259,6 +1271,109 @@ func TestQuestionMark(t *testing.T) {
 	}
 }
 
+// TestFetchArraySize checks fetching with array sizes smaller, equal to, and larger than the number of rows
+func TestFetchArraySize(t *testing.T) {
+	if TestDisableDatabase {
+		t.SkipNow()
+	}
+
+	t.Parallel()
+
+	var err error
+
+	db := testGetDB("?fetch_array_size=7")
+	if db == nil {
+		t.Fatal("db is null")
+	}
+
+	defer func() {
+		err = db.Close()
+		if err != nil {
+			t.Fatal("db close error:", err)
+		}
+	}()
+
+	query := "select level, to_char(level), systimestamp from dual connect by level <= 25"
+
+	for _, args := range [][]interface{}{nil, {FetchArraySize(1)}, {FetchArraySize(5)}, {FetchArraySize(25)}, {FetchArraySize(100)}} {
+		var stmt *sql.Stmt
+		ctx, cancel := context.WithTimeout(context.Background(), TestContextTimeout)
+		stmt, err = db.PrepareContext(ctx, query)
+		cancel()
+		if err != nil {
+			t.Fatal("prepare error:", err)
+		}
+
+		var result [][]interface{}
+		result, err = testGetRows(t, stmt, args)
+		stmt.Close()
+		if err != nil {
+			t.Fatal("get rows error:", err)
+		}
+		if len(result) != 25 {
+			t.Fatalf("len result: received: %v - expected: %v - args: %v", len(result), 25, args)
+		}
+		for i := 0; i < len(result); i++ {
+			if result[i][0] != float64(i+1) || result[i][1] != strconv.Itoa(i+1) {
+				t.Fatalf("row %v: received: %v, %v - expected: %v - args: %v", i, result[i][0], result[i][1], i+1, args)
+			}
+			if _, ok := result[i][2].(time.Time); !ok {
+				t.Fatalf("row %v: received: %T - expected: time.Time", i, result[i][2])
+			}
+		}
+	}
+}
+
+// TestBindInfo tests the number of inputs from the bind info, repeated bind names, and mixing named and positional binds
+func TestBindInfo(t *testing.T) {
+	if TestDisableDatabase {
+		t.SkipNow()
+	}
+
+	t.Parallel()
+
+	queryResults := testQueryResults{
+		query: "select :a, :b, :a from dual",
+		queryResults: []testQueryResult{
+			{
+				args:    []interface{}{1, 2},
+				results: [][]interface{}{{float64(1), float64(2), float64(1)}},
+			},
+			{
+				args:    []interface{}{sql.Named("b", 2), sql.Named("a", 1)},
+				results: [][]interface{}{{float64(1), float64(2), float64(1)}},
+			},
+			{
+				args:    []interface{}{1, sql.Named("b", 2)},
+				results: [][]interface{}{{float64(1), float64(2), float64(1)}},
+			},
+		},
+	}
+	testRunQueryResults(t, queryResults)
+
+	tests := []struct {
+		query string
+		args  []interface{}
+	}{
+		{query: "select :a, :b, :a from dual", args: []interface{}{1}},
+		{query: "select :a, :b, :a from dual", args: []interface{}{1, 2, 3}},
+		{query: "select :a, :b, :a from dual", args: []interface{}{sql.Named("a", 1), 2}},
+		{query: "select :a, :b, :a from dual", args: []interface{}{sql.Named("a", 1), sql.Named("c", 2)}},
+		{query: "select 1 from dual", args: []interface{}{1}},
+	}
+
+	for _, test := range tests {
+		ctx, cancel := context.WithTimeout(context.Background(), TestContextTimeout)
+		rows, err := TestDB.QueryContext(ctx, test.query, test.args...)
+		if err == nil {
+			rows.Close()
+			cancel()
+			t.Fatalf("query %v with args %v: expected error", test.query, test.args)
+		}
+		cancel()
+	}
+}
+
 func BenchmarkSimpleInsert(b *testing.B) {
 	if TestDisableDatabase || TestDisableDestructive {
 		b.SkipNow()
@@ -3,6 +3,7 @@ package oci8
 import (
 	"context"
 	"database/sql"
+	"strings"
 	"testing"
 	"time"
 )
@@ -92,43 +93,43 @@ func TestSelectDualTime(t *testing.T) {
 	queryResultTimeYearToMonth := []testQueryResult{
 		{
 			args:    []interface{}{int64(-2)},
-			results: [][]interface{}{{int64(-24)}},
+			results: [][]interface{}{{IntervalYM(-24)}},
 		},
 		{
 			args:    []interface{}{int64(-1)},
-			results: [][]interface{}{{int64(-12)}},
+			results: [][]interface{}{{IntervalYM(-12)}},
 		},
 		{
 			args:    []interface{}{int64(0)},
-			results: [][]interface{}{{int64(0)}},
+			results: [][]interface{}{{IntervalYM(0)}},
 		},
 		{
 			args:    []interface{}{int64(1)},
-			results: [][]interface{}{{int64(12)}},
+			results: [][]interface{}{{IntervalYM(12)}},
 		},
 		{
 			args:    []interface{}{int64(2)},
-			results: [][]interface{}{{int64(24)}},
+			results: [][]interface{}{{IntervalYM(24)}},
 		},
 		{
 			args:    []interface{}{float64(-2.5)},
-			results: [][]interface{}{{int64(-30)}},
+			results: [][]interface{}{{IntervalYM(-30)}},
 		},
 		{
 			args:    []interface{}{float64(-1.25)},
-			results: [][]interface{}{{int64(-15)}},
+			results: [][]interface{}{{IntervalYM(-15)}},
 		},
 		{
 			args:    []interface{}{float64(0)},
-			results: [][]interface{}{{int64(0)}},
+			results: [][]interface{}{{IntervalYM(0)}},
 		},
 		{
 			args:    []interface{}{float64(1.25)},
-			results: [][]interface{}{{int64(15)}},
+			results: [][]interface{}{{IntervalYM(15)}},
 		},
 		{
 			args:    []interface{}{float64(2.5)},
-			results: [][]interface{}{{int64(30)}},
+			results: [][]interface{}{{IntervalYM(30)}},
 		},
 	}
 
@@ -140,43 +141,43 @@ func TestSelectDualTime(t *testing.T) {
 	queryResultTimeMonthToMonth := []testQueryResult{
 		{
 			args:    []interface{}{int64(-2)},
-			results: [][]interface{}{{int64(-2)}},
+			results: [][]interface{}{{IntervalYM(-2)}},
 		},
 		{
 			args:    []interface{}{int64(-1)},
-			results: [][]interface{}{{int64(-1)}},
+			results: [][]interface{}{{IntervalYM(-1)}},
 		},
 		{
 			args:    []interface{}{int64(0)},
-			results: [][]interface{}{{int64(0)}},
+			results: [][]interface{}{{IntervalYM(0)}},
 		},
 		{
 			args:    []interface{}{int64(1)},
-			results: [][]interface{}{{int64(1)}},
+			results: [][]interface{}{{IntervalYM(1)}},
 		},
 		{
 			args:    []interface{}{int64(2)},
-			results: [][]interface{}{{int64(2)}},
+			results: [][]interface{}{{IntervalYM(2)}},
 		},
 		{
 			args:    []interface{}{float64(-2.75)},
-			results: [][]interface{}{{int64(-3)}},
+			results: [][]interface{}{{IntervalYM(-3)}},
 		},
 		{
 			args:    []interface{}{float64(-1.25)},
-			results: [][]interface{}{{int64(-1)}},
+			results: [][]interface{}{{IntervalYM(-1)}},
 		},
 		{
 			args:    []interface{}{float64(0)},
-			results: [][]interface{}{{int64(0)}},
+			results: [][]interface{}{{IntervalYM(0)}},
 		},
 		{
 			args:    []interface{}{float64(1.25)},
-			results: [][]interface{}{{int64(1)}},
+			results: [][]interface{}{{IntervalYM(1)}},
 		},
 		{
 			args:    []interface{}{float64(2.75)},
-			results: [][]interface{}{{int64(3)}},
+			results: [][]interface{}{{IntervalYM(3)}},
 		},
 	}
 
@@ -188,43 +189,43 @@ func TestSelectDualTime(t *testing.T) {
 	queryResultTimeDayToSecond := []testQueryResult{
 		{
 			args:    []interface{}{int64(-2)},
-			results: [][]interface{}{{int64(-172800000000000)}},
+			results: [][]interface{}{{IntervalDS(-172800000000000)}},
 		},
 		{
 			args:    []interface{}{int64(-1)},
-			results: [][]interface{}{{int64(-86400000000000)}},
+			results: [][]interface{}{{IntervalDS(-86400000000000)}},
 		},
 		{
 			args:    []interface{}{int64(0)},
-			results: [][]interface{}{{int64(0)}},
+			results: [][]interface{}{{IntervalDS(0)}},
 		},
 		{
 			args:    []interface{}{int64(1)},
-			results: [][]interface{}{{int64(86400000000000)}},
+			results: [][]interface{}{{IntervalDS(86400000000000)}},
 		},
 		{
 			args:    []interface{}{int64(2)},
-			results: [][]interface{}{{int64(172800000000000)}},
+			results: [][]interface{}{{IntervalDS(172800000000000)}},
 		},
 		{
 			args:    []interface{}{float64(-2.5)},
-			results: [][]interface{}{{int64(-216000000000000)}},
+			results: [][]interface{}{{IntervalDS(-216000000000000)}},
 		},
 		{
 			args:    []interface{}{float64(-1.25)},
-			results: [][]interface{}{{int64(-108000000000000)}},
+			results: [][]interface{}{{IntervalDS(-108000000000000)}},
 		},
 		{
 			args:    []interface{}{float64(0)},
-			results: [][]interface{}{{int64(0)}},
+			results: [][]interface{}{{IntervalDS(0)}},
 		},
 		{
 			args:    []interface{}{float64(1.25)},
-			results: [][]interface{}{{int64(108000000000000)}},
+			results: [][]interface{}{{IntervalDS(108000000000000)}},
 		},
 		{
 			args:    []interface{}{float64(2.5)},
-			results: [][]interface{}{{int64(216000000000000)}},
+			results: [][]interface{}{{IntervalDS(216000000000000)}},
 		},
 	}
 
@@ -236,43 +237,43 @@ func TestSelectDualTime(t *testing.T) {
 	queryResultTimeHourToSecond := []testQueryResult{
 		{
 			args:    []interface{}{int64(-2)},
-			results: [][]interface{}{{int64(-7200000000000)}},
+			results: [][]interface{}{{IntervalDS(-7200000000000)}},
 		},
 		{
 			args:    []interface{}{int64(-1)},
-			results: [][]interface{}{{int64(-3600000000000)}},
+			results: [][]interface{}{{IntervalDS(-3600000000000)}},
 		},
 		{
 			args:    []interface{}{int64(0)},
-			results: [][]interface{}{{int64(0)}},
+			results: [][]interface{}{{IntervalDS(0)}},
 		},
 		{
 			args:    []interface{}{int64(1)},
-			results: [][]interface{}{{int64(3600000000000)}},
+			results: [][]interface{}{{IntervalDS(3600000000000)}},
 		},
 		{
 			args:    []interface{}{int64(2)},
-			results: [][]interface{}{{int64(7200000000000)}},
+			results: [][]interface{}{{IntervalDS(7200000000000)}},
 		},
 		{
 			args:    []interface{}{float64(-2.5)},
-			results: [][]interface{}{{int64(-9000000000000)}},
+			results: [][]interface{}{{IntervalDS(-9000000000000)}},
 		},
 		{
 			args:    []interface{}{float64(-1.25)},
-			results: [][]interface{}{{int64(-4500000000000)}},
+			results: [][]interface{}{{IntervalDS(-4500000000000)}},
 		},
 		{
 			args:    []interface{}{float64(0)},
-			results: [][]interface{}{{int64(0)}},
+			results: [][]interface{}{{IntervalDS(0)}},
 		},
 		{
 			args:    []interface{}{float64(1.25)},
-			results: [][]interface{}{{int64(4500000000000)}},
+			results: [][]interface{}{{IntervalDS(4500000000000)}},
 		},
 		{
 			args:    []interface{}{float64(2.5)},
-			results: [][]interface{}{{int64(9000000000000)}},
+			results: [][]interface{}{{IntervalDS(9000000000000)}},
 		},
 	}
 
@@ -284,43 +285,43 @@ func TestSelectDualTime(t *testing.T) {
 	queryResultTimeMinuteToSecond := []testQueryResult{
 		{
 			args:    []interface{}{int64(-2)},
-			results: [][]interface{}{{int64(-120000000000)}},
+			results: [][]interface{}{{IntervalDS(-120000000000)}},
 		},
 		{
 			args:    []interface{}{int64(-1)},
-			results: [][]interface{}{{int64(-60000000000)}},
+			results: [][]interface{}{{IntervalDS(-60000000000)}},
 		},
 		{
 			args:    []interface{}{int64(0)},
-			results: [][]interface{}{{int64(0)}},
+			results: [][]interface{}{{IntervalDS(0)}},
 		},
 		{
 			args:    []interface{}{int64(1)},
-			results: [][]interface{}{{int64(60000000000)}},
+			results: [][]interface{}{{IntervalDS(60000000000)}},
 		},
 		{
 			args:    []interface{}{int64(2)},
-			results: [][]interface{}{{int64(120000000000)}},
+			results: [][]interface{}{{IntervalDS(120000000000)}},
 		},
 		{
 			args:    []interface{}{float64(-2.5)},
-			results: [][]interface{}{{int64(-150000000000)}},
+			results: [][]interface{}{{IntervalDS(-150000000000)}},
 		},
 		{
 			args:    []interface{}{float64(-1.25)},
-			results: [][]interface{}{{int64(-75000000000)}},
+			results: [][]interface{}{{IntervalDS(-75000000000)}},
 		},
 		{
 			args:    []interface{}{float64(0)},
-			results: [][]interface{}{{int64(0)}},
+			results: [][]interface{}{{IntervalDS(0)}},
 		},
 		{
 			args:    []interface{}{float64(1.25)},
-			results: [][]interface{}{{int64(75000000000)}},
+			results: [][]interface{}{{IntervalDS(75000000000)}},
 		},
 		{
 			args:    []interface{}{float64(2.5)},
-			results: [][]interface{}{{int64(150000000000)}},
+			results: [][]interface{}{{IntervalDS(150000000000)}},
 		},
 	}
 
@@ -332,43 +333,43 @@ func TestSelectDualTime(t *testing.T) {
 	queryResultTimeSecondToSecond := []testQueryResult{
 		{
 			args:    []interface{}{int64(-2)},
-			results: [][]interface{}{{int64(-2000000000)}},
+			results: [][]interface{}{{IntervalDS(-2000000000)}},
 		},
 		{
 			args:    []interface{}{int64(-1)},
-			results: [][]interface{}{{int64(-1000000000)}},
+			results: [][]interface{}{{IntervalDS(-1000000000)}},
 		},
 		{
 			args:    []interface{}{int64(0)},
-			results: [][]interface{}{{int64(0)}},
+			results: [][]interface{}{{IntervalDS(0)}},
 		},
 		{
 			args:    []interface{}{int64(1)},
-			results: [][]interface{}{{int64(1000000000)}},
+			results: [][]interface{}{{IntervalDS(1000000000)}},
 		},
 		{
 			args:    []interface{}{int64(2)},
-			results: [][]interface{}{{int64(2000000000)}},
+			results: [][]interface{}{{IntervalDS(2000000000)}},
 		},
 		{
 			args:    []interface{}{float64(-2.5)},
-			results: [][]interface{}{{int64(-2500000000)}},
+			results: [][]interface{}{{IntervalDS(-2500000000)}},
 		},
 		{
 			args:    []interface{}{float64(-1.25)},
-			results: [][]interface{}{{int64(-1250000000)}},
+			results: [][]interface{}{{IntervalDS(-1250000000)}},
 		},
 		{
 			args:    []interface{}{float64(0)},
-			results: [][]interface{}{{int64(0)}},
+			results: [][]interface{}{{IntervalDS(0)}},
 		},
 		{
 			args:    []interface{}{float64(1.25)},
-			results: [][]interface{}{{int64(1250000000)}},
+			results: [][]interface{}{{IntervalDS(1250000000)}},
 		},
 		{
 			args:    []interface{}{float64(2.5)},
-			results: [][]interface{}{{int64(2500000000)}},
+			results: [][]interface{}{{IntervalDS(2500000000)}},
 		},
 	}
 
@@ -378,6 +379,266 @@ func TestSelectDualTime(t *testing.T) {
 	testRunQueryResults(t, queryResults)
 }
 
+// TestSelectDualInterval checks binding IntervalDS and IntervalYM
+func TestSelectDualInterval(t *testing.T) {
+	if TestDisableDatabase {
+		t.SkipNow()
+	}
+
+	t.Parallel()
+
+	queryResults := testQueryResults{
+		query: "select :1, :2 from dual",
+		queryResults: []testQueryResult{
+			{
+				args:    []interface{}{IntervalDS(26*time.Hour + 3*time.Minute + 4*time.Second + 5), IntervalYM(14)},
+				results: [][]interface{}{{IntervalDS(26*time.Hour + 3*time.Minute + 4*time.Second + 5), IntervalYM(14)}},
+			},
+			{
+				args:    []interface{}{IntervalDS(-time.Second / 2), IntervalYM(-25)},
+				results: [][]interface{}{{IntervalDS(-time.Second / 2), IntervalYM(-25)}},
+			},
+		},
+	}
+	testRunQueryResults(t, queryResults)
+
+	queryResults = testQueryResults{
+		query: "select :1 + INTERVAL '1 00:00:00' DAY TO SECOND, :2 + INTERVAL '1-00' YEAR TO MONTH from dual",
+		queryResults: []testQueryResult{
+			{
+				args:    []interface{}{IntervalDS(time.Hour), IntervalYM(1)},
+				results: [][]interface{}{{IntervalDS(25 * time.Hour), IntervalYM(13)}},
+			},
+		},
+	}
+	testRunQueryResults(t, queryResults)
+
+	// NULL intervals and dates scan into the Null types
+	var nullIntervalDS NullIntervalDS
+	var nullIntervalYM NullIntervalYM
+	var nullDate NullDate
+	var nullTimestamp NullTimestamp
+	ctx, cancel := context.WithTimeout(context.Background(), TestContextTimeout)
+	err := TestDB.QueryRowContext(ctx, "select cast(null as INTERVAL DAY TO SECOND), cast(null as INTERVAL YEAR TO MONTH), cast(null as DATE), :1 from dual",
+		NullTimestamp{}).Scan(&nullIntervalDS, &nullIntervalYM, &nullDate, &nullTimestamp)
+	cancel()
+	if err != nil {
+		t.Fatal("scan error:", err)
+	}
+	if nullIntervalDS.Valid || nullIntervalYM.Valid || nullDate.Valid || nullTimestamp.Valid {
+		t.Errorf("NULL: received: %v, %v, %v, %v - expected not valid", nullIntervalDS, nullIntervalYM, nullDate, nullTimestamp)
+	}
+
+	aTime := time.Date(2099, 1, 2, 3, 4, 5, 0, time.UTC)
+	ctx, cancel = context.WithTimeout(context.Background(), TestContextTimeout)
+	err = TestDB.QueryRowContext(ctx, "select :1, :2, :3 from dual",
+		NullIntervalDS{IntervalDS: IntervalDS(time.Hour), Valid: true}, NullIntervalYM{IntervalYM: 14, Valid: true}, NullDate{Date: Date(aTime), Valid: true}).
+		Scan(&nullIntervalDS, &nullIntervalYM, &nullDate)
+	cancel()
+	if err != nil {
+		t.Fatal("scan error:", err)
+	}
+	if !nullIntervalDS.Valid || nullIntervalDS.IntervalDS != IntervalDS(time.Hour) {
+		t.Errorf("NullIntervalDS: received: %v - expected: %v", nullIntervalDS, IntervalDS(time.Hour))
+	}
+	if !nullIntervalYM.Valid || nullIntervalYM.IntervalYM != 14 {
+		t.Errorf("NullIntervalYM: received: %v - expected: %v", nullIntervalYM, IntervalYM(14))
+	}
+	if !nullDate.Valid || !nullDate.Date.Time().Equal(aTime) {
+		t.Errorf("NullDate: received: %v - expected: %v", nullDate, aTime)
+	}
+
+	var intervalDS IntervalDS
+	ctx, cancel = context.WithTimeout(context.Background(), TestContextTimeout)
+	err = TestDB.QueryRowContext(ctx, "select cast(null as INTERVAL DAY TO SECOND) from dual").Scan(&intervalDS)
+	cancel()
+	if err == nil {
+		t.Error("IntervalDS scan NULL: expected error")
+	}
+}
+
+// TestTimeBind checks binding Date, Timestamp, and TimestampTZ, and the time_bind DSN parameter
+func TestTimeBind(t *testing.T) {
+	if TestDisableDatabase {
+		t.SkipNow()
+	}
+
+	t.Parallel()
+
+	aTime := time.Date(2099, 1, 2, 3, 4, 5, 123456789, time.UTC)
+
+	// dump type 12 is DATE, 180 is TIMESTAMP, and 181 is TIMESTAMP WITH TIME ZONE
+	tests := []struct {
+		arg      interface{}
+		dumpType string
+	}{
+		{arg: aTime, dumpType: "Typ=181 "},
+		{arg: Date(aTime), dumpType: "Typ=12 "},
+		{arg: Timestamp(aTime), dumpType: "Typ=180 "},
+		{arg: TimestampTZ(aTime), dumpType: "Typ=181 "},
+	}
+	for _, test := range tests {
+		var dump string
+		ctx, cancel := context.WithTimeout(context.Background(), TestContextTimeout)
+		err := TestDB.QueryRowContext(ctx, "select dump(:1) from dual", test.arg).Scan(&dump)
+		cancel()
+		if err != nil {
+			t.Fatalf("query error for %T: %v", test.arg, err)
+		}
+		if !strings.HasPrefix(dump, test.dumpType) {
+			t.Errorf("dump for %T: received: %v - expected: %v", test.arg, dump, test.dumpType)
+		}
+	}
+
+	var date Date
+	var timestamp Timestamp
+	var timestampTZ TimestampTZ
+	ctx, cancel := context.WithTimeout(context.Background(), TestContextTimeout)
+	_, err := TestDB.ExecContext(ctx, "begin :1 := :4; :2 := :4; :3 := :4; end;",
+		sql.Out{Dest: &date}, sql.Out{Dest: &timestamp}, sql.Out{Dest: &timestampTZ}, TimestampTZ(aTime))
+	cancel()
+	if err != nil {
+		t.Fatal("exec error:", err)
+	}
+	if !date.Time().Equal(aTime.Truncate(time.Second)) {
+		t.Errorf("date: received: %v - expected: %v", date.Time(), aTime.Truncate(time.Second))
+	}
+	if !timestamp.Time().Equal(aTime) {
+		t.Errorf("timestamp: received: %v - expected: %v", timestamp.Time(), aTime)
+	}
+	if !timestampTZ.Time().Equal(aTime) {
+		t.Errorf("timestampTZ: received: %v - expected: %v", timestampTZ.Time(), aTime)
+	}
+
+	db := testGetDB("?time_bind=date")
+	if db == nil {
+		t.Fatal("db is null")
+	}
+	defer db.Close()
+
+	var dump string
+	var outTime time.Time
+	ctx, cancel = context.WithTimeout(context.Background(), TestContextTimeout)
+	err = db.QueryRowContext(ctx, "select dump(:1) from dual", aTime).Scan(&dump)
+	cancel()
+	if err != nil {
+		t.Fatal("query error:", err)
+	}
+	if !strings.HasPrefix(dump, "Typ=12 ") {
+		t.Errorf("dump: received: %v - expected: %v", dump, "Typ=12 ")
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), TestContextTimeout)
+	_, err = db.ExecContext(ctx, "begin :1 := :2; end;", sql.Out{Dest: &outTime}, aTime)
+	cancel()
+	if err != nil {
+		t.Fatal("exec error:", err)
+	}
+	if !outTime.Equal(aTime.Truncate(time.Second)) {
+		t.Errorf("out time: received: %v - expected: %v", outTime, aTime.Truncate(time.Second))
+	}
+}
+
+// TestTimeZoneRegion checks time zone region names are kept when binding and fetching TIMESTAMP WITH TIME ZONE
+func TestTimeZoneRegion(t *testing.T) {
+	if TestDisableDatabase {
+		t.SkipNow()
+	}
+
+	t.Parallel()
+
+	location, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("load location error:", err)
+	}
+
+	var aTime time.Time
+	ctx, cancel := context.WithTimeout(context.Background(), TestContextTimeout)
+	err = TestDB.QueryRowContext(ctx, "select TIMESTAMP '2099-07-01 12:00:00 America/New_York' from dual").Scan(&aTime)
+	cancel()
+	if err != nil {
+		t.Fatal("query error:", err)
+	}
+	expected := time.Date(2099, 7, 1, 12, 0, 0, 0, location)
+	if !aTime.Equal(expected) || aTime.Location().String() != "America/New_York" {
+		t.Errorf("time: received: %v %v - expected: %v %v", aTime, aTime.Location(), expected, expected.Location())
+	}
+
+	// add six months over the daylight saving time change, the offset changes with a region but not with an offset
+	var region string
+	ctx, cancel = context.WithTimeout(context.Background(), TestContextTimeout)
+	err = TestDB.QueryRowContext(ctx, "select to_char(:1, 'TZR'), :1 + INTERVAL '6' MONTH from dual", expected).Scan(&region, &aTime)
+	cancel()
+	if err != nil {
+		t.Fatal("query error:", err)
+	}
+	if !strings.EqualFold(region, "America/New_York") {
+		t.Errorf("region: received: %v - expected: %v", region, "America/New_York")
+	}
+	expected = time.Date(2100, 1, 1, 12, 0, 0, 0, location)
+	if !aTime.Equal(expected) {
+		t.Errorf("time: received: %v - expected: %v", aTime, expected)
+	}
+}
+
+// TestSessionTimeZone checks the session_tz DSN parameter and fetching TIMESTAMP WITH LOCAL TIME ZONE
+func TestSessionTimeZone(t *testing.T) {
+	if TestDisableDatabase {
+		t.SkipNow()
+	}
+
+	t.Parallel()
+
+	db := testGetDB("?session_tz=%2B05:00")
+	if db == nil {
+		t.Fatal("db is null")
+	}
+	defer db.Close()
+
+	var sessionTimeZone string
+	var aTime time.Time
+	ctx, cancel := context.WithTimeout(context.Background(), TestContextTimeout)
+	err := db.QueryRowContext(ctx,
+		"select sessiontimezone, cast(TIMESTAMP '2099-01-02 03:04:05.123456789 UTC' as TIMESTAMP(9) WITH LOCAL TIME ZONE) from dual").Scan(&sessionTimeZone, &aTime)
+	cancel()
+	if err != nil {
+		t.Fatal("query error:", err)
+	}
+	if sessionTimeZone != "+05:00" {
+		t.Errorf("session time zone: received: %v - expected: %v", sessionTimeZone, "+05:00")
+	}
+
+	// local time zone values are fetched in the session time zone
+	expected := time.Date(2099, 1, 2, 8, 4, 5, 123456789, time.FixedZone("+05:00", 5*3600))
+	if !aTime.Equal(expected) {
+		t.Errorf("time: received: %v - expected: %v", aTime, expected)
+	}
+	if _, offset := aTime.Zone(); offset != 5*3600 {
+		t.Errorf("offset: received: %v - expected: %v", offset, 5*3600)
+	}
+
+	// TIMESTAMP converts to and from the session time zone when compared to a local time zone
+	var count int64
+	ctx, cancel = context.WithTimeout(context.Background(), TestContextTimeout)
+	err = db.QueryRowContext(ctx,
+		"select count(1) from dual where cast(:1 as TIMESTAMP(9) WITH LOCAL TIME ZONE) = TIMESTAMP '2099-01-02 08:04:05.123456789'",
+		expected).Scan(&count)
+	cancel()
+	if err != nil {
+		t.Fatal("query error:", err)
+	}
+	if count != 1 {
+		t.Errorf("count: received: %v - expected: %v", count, 1)
+	}
+
+	// connect fails with an invalid session time zone
+	db2 := testGetDB("?session_tz=Not_A_Time_Zone")
+	if db2 != nil {
+		db2.Close()
+		t.Error("expected connect error for invalid session_tz")
+	}
+}
+
 // TestDestructiveTime checks insert, select, update, and delete of time types
 func TestDestructiveTime(t *testing.T) {
 	if TestDisableDatabase || TestDisableDestructive {
@@ -544,13 +805,13 @@ func TestDestructiveTime(t *testing.T) {
 		queryResults: []testQueryResult{
 			{
 				results: [][]interface{}{
-					{int64(1), int64(-24), int64(-2)},
-					{int64(2), int64(-12), int64(-1)},
-					{int64(3), int64(12), int64(1)},
-					{int64(4), int64(24), int64(2)},
-					{int64(5), int64(15), int64(2)},
-					{int64(6), int64(18), int64(3)},
-					{int64(7), int64(33), int64(3)},
+					{int64(1), IntervalYM(-24), IntervalYM(-2)},
+					{int64(2), IntervalYM(-12), IntervalYM(-1)},
+					{int64(3), IntervalYM(12), IntervalYM(1)},
+					{int64(4), IntervalYM(24), IntervalYM(2)},
+					{int64(5), IntervalYM(15), IntervalYM(2)},
+					{int64(6), IntervalYM(18), IntervalYM(3)},
+					{int64(7), IntervalYM(33), IntervalYM(3)},
 				},
 			},
 		},
@@ -572,10 +833,10 @@ func TestDestructiveTime(t *testing.T) {
 		queryResults: []testQueryResult{
 			{
 				results: [][]interface{}{
-					{int64(1), int64(-24), int64(-2)},
-					{int64(2), int64(-12), int64(-1)},
-					{int64(3), int64(12), int64(1)},
-					{int64(4), int64(24), int64(2)},
+					{int64(1), IntervalYM(-24), IntervalYM(-2)},
+					{int64(2), IntervalYM(-12), IntervalYM(-1)},
+					{int64(3), IntervalYM(12), IntervalYM(1)},
+					{int64(4), IntervalYM(24), IntervalYM(2)},
 				},
 			},
 		},
@@ -611,13 +872,13 @@ func TestDestructiveTime(t *testing.T) {
 		queryResults: []testQueryResult{
 			{
 				results: [][]interface{}{
-					{int64(1), int64(-172800000000000), int64(-7200000000000)},
-					{int64(2), int64(-86400000000000), int64(-3600000000000)},
-					{int64(3), int64(86400000000000), int64(3600000000000)},
-					{int64(4), int64(172800000000000), int64(7200000000000)},
-					{int64(5), int64(108000000000000), int64(4500000000000)},
-					{int64(6), int64(129600000000000), int64(5400000000000)},
-					{int64(7), int64(237600000000000), int64(9900000000000)},
+					{int64(1), IntervalDS(-172800000000000), IntervalDS(-7200000000000)},
+					{int64(2), IntervalDS(-86400000000000), IntervalDS(-3600000000000)},
+					{int64(3), IntervalDS(86400000000000), IntervalDS(3600000000000)},
+					{int64(4), IntervalDS(172800000000000), IntervalDS(7200000000000)},
+					{int64(5), IntervalDS(108000000000000), IntervalDS(4500000000000)},
+					{int64(6), IntervalDS(129600000000000), IntervalDS(5400000000000)},
+					{int64(7), IntervalDS(237600000000000), IntervalDS(9900000000000)},
 				},
 			},
 		},
@@ -639,10 +900,10 @@ func TestDestructiveTime(t *testing.T) {
 		queryResults: []testQueryResult{
 			{
 				results: [][]interface{}{
-					{int64(1), int64(-172800000000000), int64(-7200000000000)},
-					{int64(2), int64(-86400000000000), int64(-3600000000000)},
-					{int64(3), int64(86400000000000), int64(3600000000000)},
-					{int64(4), int64(172800000000000), int64(7200000000000)},
+					{int64(1), IntervalDS(-172800000000000), IntervalDS(-7200000000000)},
+					{int64(2), IntervalDS(-86400000000000), IntervalDS(-3600000000000)},
+					{int64(3), IntervalDS(86400000000000), IntervalDS(3600000000000)},
+					{int64(4), IntervalDS(172800000000000), IntervalDS(7200000000000)},
 				},
 			},
 		},
@@ -673,13 +934,13 @@ func TestDestructiveTime(t *testing.T) {
 		queryResults: []testQueryResult{
 			{
 				results: [][]interface{}{
-					{int64(1), int64(-120000000000), int64(-2000000000)},
-					{int64(2), int64(-60000000000), int64(-1000000000)},
-					{int64(3), int64(60000000000), int64(1000000000)},
-					{int64(4), int64(120000000000), int64(2000000000)},
-					{int64(5), int64(75000000000), int64(1250000000)},
-					{int64(6), int64(90000000000), int64(1500000000)},
-					{int64(7), int64(165000000000), int64(2750000000)},
+					{int64(1), IntervalDS(-120000000000), IntervalDS(-2000000000)},
+					{int64(2), IntervalDS(-60000000000), IntervalDS(-1000000000)},
+					{int64(3), IntervalDS(60000000000), IntervalDS(1000000000)},
+					{int64(4), IntervalDS(120000000000), IntervalDS(2000000000)},
+					{int64(5), IntervalDS(75000000000), IntervalDS(1250000000)},
+					{int64(6), IntervalDS(90000000000), IntervalDS(1500000000)},
+					{int64(7), IntervalDS(165000000000), IntervalDS(2750000000)},
 				},
 			},
 		},
@@ -701,10 +962,10 @@ func TestDestructiveTime(t *testing.T) {
 		queryResults: []testQueryResult{
 			{
 				results: [][]interface{}{
-					{int64(1), int64(-120000000000), int64(-2000000000)},
-					{int64(2), int64(-60000000000), int64(-1000000000)},
-					{int64(3), int64(60000000000), int64(1000000000)},
-					{int64(4), int64(120000000000), int64(2000000000)},
+					{int64(1), IntervalDS(-120000000000), IntervalDS(-2000000000)},
+					{int64(2), IntervalDS(-60000000000), IntervalDS(-1000000000)},
+					{int64(3), IntervalDS(60000000000), IntervalDS(1000000000)},
+					{int64(4), IntervalDS(120000000000), IntervalDS(2000000000)},
 				},
 			},
 		},
@@ -745,7 +1006,7 @@ func TestDestructiveTimeColumnTypes(t *testing.T) {
 					{time.Date(2099, 1, 2, 3, 4, 5, 123456789, time.UTC),
 						time.Date(2099, 1, 2, 3, 4, 5, 123456789, time.UTC),
 						time.Date(2099, 1, 2, 3, 4, 5, 123456789, time.UTC),
-						int64(10), int64(36000000000000)},
+						IntervalYM(10), IntervalDS(36000000000000)},
 				}},
 		},
 	}
@@ -840,7 +1101,7 @@ func TestDestructiveTimeColumnTypes(t *testing.T) {
 
 	columnNum = 2
 
-	if columnTypes[columnNum].DatabaseTypeName() != "SQLT_TIMESTAMP_TZ" {
+	if columnTypes[columnNum].DatabaseTypeName() != "SQLT_TIMESTAMP_LTZ" {
 		t.Error("DatabaseTypeName does not match -", columnTypes[columnNum].DatabaseTypeName())
 	}
 
@@ -880,7 +1141,7 @@ func TestDestructiveTimeColumnTypes(t *testing.T) {
 		t.Error("Name does not match -", columnTypes[columnNum].Name())
 	}
 
-	if columnTypes[columnNum].ScanType() != typeInt64 {
+	if columnTypes[columnNum].ScanType() != typeIntervalYM {
 		t.Error("ScanType does not match -", columnTypes[columnNum].ScanType())
 	}
 
@@ -904,7 +1165,7 @@ func TestDestructiveTimeColumnTypes(t *testing.T) {
 		t.Error("Name does not match -", columnTypes[columnNum].Name())
 	}
 
-	if columnTypes[columnNum].ScanType() != typeInt64 {
+	if columnTypes[columnNum].ScanType() != typeIntervalDS {
 		t.Error("ScanType does not match -", columnTypes[columnNum].ScanType())
 	}
 
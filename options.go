@@ -0,0 +1,91 @@
+package oci8
+
+import (
+	"strconv"
+)
+
+// apply calls the option func
+func (f stmtOptionFunc) apply(options *stmtOptions) {
+	f(options)
+}
+
+// BatchErrors returns an option that executes an array DML exec with OCI_BATCH_ERRORS.
+// Rows that fail do not stop the other rows from being applied.
+// If any rows fail, Exec returns a *BatchError listing the failed rows, the other rows are still applied.
+func BatchErrors() StmtOption {
+	return stmtOptionFunc(func(options *stmtOptions) {
+		options.batchErrors = true
+	})
+}
+
+// RowCounts returns an option that stores the number of rows affected by each row of an array DML exec into dest.
+func RowCounts(dest *[]int64) StmtOption {
+	return stmtOptionFunc(func(options *stmtOptions) {
+		options.rowCounts = dest
+	})
+}
+
+// FetchArraySize returns an option that sets the number of rows fetched into the driver buffers per fetch call for a query.
+// Overrides the fetch_array_size DSN parameter.
+func FetchArraySize(size int) StmtOption {
+	return stmtOptionFunc(func(options *stmtOptions) {
+		options.fetchArraySize = size
+	})
+}
+
+// ExactNumber returns an option that fetches the NUMBER columns of a query as Number, see the exact_number DSN parameter
+func ExactNumber() StmtOption {
+	return stmtOptionFunc(func(options *stmtOptions) {
+		options.exactNumber = true
+	})
+}
+
+// LobLocator returns an option that fetches the BLOB, CLOB, and NCLOB columns of a query as *Lob without reading their content,
+// see the lob_fetch DSN parameter. Each *Lob is valid until the rows are closed,
+// a Lob it is scanned into is valid until the Lob is closed, so scan into a Lob to use it after the rows are closed.
+func LobLocator() StmtOption {
+	return stmtOptionFunc(func(options *stmtOptions) {
+		options.lobLocator = true
+	})
+}
+
+// LobPrefetchSize returns an option that sets the size of the LOB data fetched with the LOB locators of a query,
+// so LOBs up to the size are read without more round trips. Overrides the lob_prefetch_size DSN parameter.
+func LobPrefetchSize(size int) StmtOption {
+	return stmtOptionFunc(func(options *stmtOptions) {
+		options.lobPrefetch = size
+	})
+}
+
+// OutSize returns an option that sets the size in bytes of the buffers of string and []byte out binds, the default is 32767.
+// The size is increased to the length of an in out value that is larger. Values larger than 32767 need a Clob or Blob destination.
+func OutSize(size int) StmtOption {
+	return stmtOptionFunc(func(options *stmtOptions) {
+		options.outSize = size
+	})
+}
+
+// Error returns the first row error and the number of rows that failed
+func (batchError *BatchError) Error() string {
+	if len(batchError.Errors) == 0 {
+		return "batch error"
+	}
+	message := batchError.Errors[0].Error()
+	if len(batchError.Errors) > 1 {
+		message += " (and " + strconv.Itoa(len(batchError.Errors)-1) + " more row errors)"
+	}
+	return message
+}
+
+// Error returns the row offset and the Oracle error
+func (rowError RowError) Error() string {
+	return "row " + strconv.Itoa(rowError.Offset) + ": " + rowError.Err.Error()
+}
+
+// Error returns the bind and the length of the value before truncation
+func (errTruncated *ErrTruncated) Error() string {
+	if errTruncated.ActualLen < 0 {
+		return "out bind " + errTruncated.Param + " value truncated"
+	}
+	return "out bind " + errTruncated.Param + " value of length " + strconv.Itoa(errTruncated.ActualLen) + " truncated"
+}
@@ -0,0 +1,161 @@
+package oci8
+
+import (
+	"bytes"
+	"strconv"
+)
+
+// placeholders rewrites the placeholders of the enabled styles in the query to Oracle bind placeholders.
+//
+// questionMark converts "?" to :1, :2, ... :n
+//
+// dollar converts $1, $2, ... $n to :1, :2, ... :n
+//
+// atName converts @name to :name
+//
+// String literals (including N'...' and q'[...]' literals), quoted identifiers, and comments are copied unchanged.
+func placeholders(query string, questionMark bool, dollar bool, atName bool) string {
+	var buffer bytes.Buffer
+	buffer.Grow(len(query) + 16)
+
+	n := 0
+	i := 0
+	for i < len(query) {
+		c := query[i]
+		switch {
+
+		case c == '\'': // string literal
+			end := skipStringLiteral(query, i)
+			buffer.WriteString(query[i:end])
+			i = end
+
+		case c == '"': // quoted identifier
+			end := skipUntil(query, i+1, "\"")
+			buffer.WriteString(query[i:end])
+			i = end
+
+		case c == '-' && i+1 < len(query) && query[i+1] == '-': // single line comment
+			end := skipUntil(query, i+2, "\n")
+			buffer.WriteString(query[i:end])
+			i = end
+
+		case c == '/' && i+1 < len(query) && query[i+1] == '*': // multi line comment
+			end := skipUntil(query, i+2, "*/")
+			buffer.WriteString(query[i:end])
+			i = end
+
+		case c == '?' && questionMark:
+			n++
+			buffer.WriteByte(':')
+			buffer.WriteString(strconv.Itoa(n))
+			i++
+
+		case c == '$' && dollar && i+1 < len(query) && isDigit(query[i+1]):
+			end := i + 1
+			for end < len(query) && isDigit(query[end]) {
+				end++
+			}
+			buffer.WriteByte(':')
+			buffer.WriteString(query[i+1 : end])
+			i = end
+
+		case c == '@' && atName && i+1 < len(query) && isIdentifierStart(query[i+1]) && (i == 0 || !isAtNameAfter(query[i-1])):
+			end := i + 1
+			for end < len(query) && isIdentifierPart(query[end]) {
+				end++
+			}
+			buffer.WriteByte(':')
+			buffer.WriteString(query[i+1 : end])
+			i = end
+
+		case isIdentifierPart(c): // identifier, keyword, or number
+			end := i + 1
+			for end < len(query) && isIdentifierPart(query[end]) {
+				end++
+			}
+			word := query[i:end]
+			if end < len(query) && query[end] == '\'' && (word == "q" || word == "Q" || word == "nq" || word == "nQ" || word == "Nq" || word == "NQ") {
+				end = skipQuoteLiteral(query, end)
+			}
+			buffer.WriteString(query[i:end])
+			i = end
+
+		default:
+			buffer.WriteByte(c)
+			i++
+
+		}
+	}
+
+	return buffer.String()
+}
+
+// skipStringLiteral returns the index after the string literal that starts with the quote at start.
+// Two single quotes in a row are an escaped quote.
+func skipStringLiteral(query string, start int) int {
+	i := start + 1
+	for i < len(query) {
+		if query[i] == '\'' {
+			if i+1 < len(query) && query[i+1] == '\'' {
+				i += 2
+				continue
+			}
+			return i + 1
+		}
+		i++
+	}
+	return len(query)
+}
+
+// skipQuoteLiteral returns the index after the q'...' literal whose quote is at start, like q'[it's]'
+func skipQuoteLiteral(query string, start int) int {
+	if start+1 >= len(query) {
+		return len(query)
+	}
+
+	closing := query[start+1]
+	switch closing {
+	case '[':
+		closing = ']'
+	case '{':
+		closing = '}'
+	case '(':
+		closing = ')'
+	case '<':
+		closing = '>'
+	}
+
+	return skipUntil(query, start+2, string([]byte{closing, '\''}))
+}
+
+// skipUntil returns the index after the first end found at or after start, or the length of the query if not found
+func skipUntil(query string, start int, end string) int {
+	if start > len(query) {
+		return len(query)
+	}
+	index := bytes.Index([]byte(query[start:]), []byte(end))
+	if index < 0 {
+		return len(query)
+	}
+	return start + index + len(end)
+}
+
+// isDigit returns true if c is 0 to 9
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// isIdentifierStart returns true if c can start a nonquoted identifier
+func isIdentifierStart(c byte) bool {
+	return ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || c >= 0x80
+}
+
+// isIdentifierPart returns true if c can be part of a nonquoted identifier or number
+func isIdentifierPart(c byte) bool {
+	return isIdentifierStart(c) || isDigit(c) || c == '_' || c == '$' || c == '#'
+}
+
+// isAtNameAfter returns true if an @ after c is a database link and not a placeholder, like table@dblink or "table"@dblink
+func isAtNameAfter(c byte) bool {
+	return isIdentifierPart(c) || c == '"' || c == ')'
+}
@@ -0,0 +1,272 @@
+package oci8
+
+/*
+#include "oci8.go.h"
+
+// oci8ReturningInBind is the OCIBindDynamic in callback, DML RETURNING binds have no input so it returns a null value
+static sb4 oci8ReturningInBind(void *ictxp, OCIBind *bindp, ub4 iter, ub4 index, void **bufpp, ub4 *alenp, ub1 *piecep, void **indpp) {
+	static sb2 nullIndicator = -1;
+	*bufpp = NULL;
+	*alenp = 0;
+	*indpp = &nullIndicator;
+	*piecep = OCI_ONE_PIECE;
+	return OCI_CONTINUE;
+}
+
+// oci8ReturningGrow grows the buffers to at least capacity elements, allocating descriptors for new elements of descriptor types.
+// Returns 0 if the memory could not be allocated.
+static int oci8ReturningGrow(oci8Returning *returning, ub4 capacity) {
+	void *buffer;
+	ub4 i;
+
+	if (capacity <= returning->capacity) {
+		return 1;
+	}
+	if (capacity < returning->capacity * 2) {
+		capacity = returning->capacity * 2;
+	}
+
+	buffer = realloc(returning->buffer, (size_t)capacity * returning->maxSize);
+	if (buffer == NULL) {
+		return 0;
+	}
+	returning->buffer = buffer;
+	buffer = realloc(returning->lengths, (size_t)capacity * sizeof(ub4));
+	if (buffer == NULL) {
+		return 0;
+	}
+	returning->lengths = buffer;
+	buffer = realloc(returning->indicators, (size_t)capacity * sizeof(sb2));
+	if (buffer == NULL) {
+		return 0;
+	}
+	returning->indicators = buffer;
+	buffer = realloc(returning->returnCodes, (size_t)capacity * sizeof(ub2));
+	if (buffer == NULL) {
+		return 0;
+	}
+	returning->returnCodes = buffer;
+
+	for (i = returning->capacity; i < capacity; i++) {
+		returning->indicators[i] = -1;
+		returning->returnCodes[i] = 0;
+		if (returning->descriptorType != 0) {
+			void **descriptor = (void **)(returning->buffer + (size_t)i * returning->maxSize);
+			if (OCIDescriptorAlloc(returning->env, descriptor, returning->descriptorType, 0, NULL) != OCI_SUCCESS) {
+				returning->capacity = i;
+				return 0;
+			}
+		}
+	}
+	returning->capacity = capacity;
+
+	return 1;
+}
+
+// oci8ReturningOutBind is the OCIBindDynamic out callback, it is called for each returned row of each iteration.
+// Index 0 is called even when an iteration returns no rows.
+static sb4 oci8ReturningOutBind(void *octxp, OCIBind *bindp, ub4 iter, ub4 index, void **bufpp, ub4 **alenpp, ub1 *piecep, void **indpp, ub2 **rcodepp) {
+	oci8Returning *returning = octxp;
+	ub4 i;
+
+	if (index == 0) {
+		ub4 rows = 0;
+		if (OCIAttrGet(bindp, OCI_HTYPE_BIND, &rows, NULL, OCI_ATTR_ROWS_RETURNED, returning->errHandle) != OCI_SUCCESS) {
+			return OCI_ERROR;
+		}
+		returning->base = returning->rows;
+		returning->rows += rows;
+		// one more element than returned for the call with index 0 when no rows are returned
+		if (!oci8ReturningGrow(returning, returning->rows + 1)) {
+			return OCI_ERROR;
+		}
+	}
+
+	i = returning->base + index;
+	returning->lengths[i] = returning->maxSize;
+	*bufpp = returning->buffer + (size_t)i * returning->maxSize;
+	*alenpp = &returning->lengths[i];
+	*indpp = &returning->indicators[i];
+	*rcodepp = &returning->returnCodes[i];
+	*piecep = OCI_ONE_PIECE;
+
+	return OCI_CONTINUE;
+}
+
+// oci8BindReturning calls OCIBindDynamic with the DML RETURNING callbacks
+static sword oci8BindReturning(OCIBind *bindp, OCIError *errhp, oci8Returning *returning) {
+	return OCIBindDynamic(bindp, errhp, NULL, oci8ReturningInBind, returning, oci8ReturningOutBind);
+}
+
+// oci8ReturningFree frees the descriptors and buffers of the returning bind
+static void oci8ReturningFree(oci8Returning *returning) {
+	ub4 i;
+	if (returning->descriptorType != 0) {
+		for (i = 0; i < returning->capacity; i++) {
+			OCIDescriptorFree(*(void **)(returning->buffer + (size_t)i * returning->maxSize), returning->descriptorType);
+		}
+	}
+	free(returning->buffer);
+	free(returning->lengths);
+	free(returning->indicators);
+	free(returning->returnCodes);
+	free(returning);
+}
+*/
+import "C"
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"unsafe"
+)
+
+// makeReturningBind fills sbind with a dynamic DML RETURNING bind if the statement is an insert, update, delete, or merge
+// and the value is a sql.Out with a pointer to a slice as Dest.
+// Returns false if the value is not bound as a DML RETURNING bind.
+// The slice is set to the values returned by all affected rows, of all iterations for array DML.
+func (stmt *Stmt) makeReturningBind(sbind *bindStruct, value interface{}) (bool, error) {
+	out, ok := value.(sql.Out)
+	if !ok {
+		return false, nil
+	}
+	destValue := reflect.ValueOf(out.Dest)
+	if destValue.Kind() != reflect.Ptr || destValue.IsNil() || !isArrayBindType(destValue.Type().Elem()) {
+		return false, nil
+	}
+
+	var stmtType C.ub2
+	_, err := stmt.ociAttrGet(unsafe.Pointer(&stmtType), C.OCI_ATTR_STMT_TYPE)
+	if err != nil {
+		return false, err
+	}
+	switch stmtType {
+	case C.OCI_STMT_INSERT, C.OCI_STMT_UPDATE, C.OCI_STMT_DELETE, C.OCI_STMT_MERGE:
+	default:
+		return false, nil
+	}
+	if out.In {
+		return false, errors.New("returning bind cannot be an in bind")
+	}
+
+	returning := (*C.oci8Returning)(C.calloc(1, C.sizeof_oci8Returning))
+	returning.env = stmt.conn.env
+	returning.errHandle = stmt.conn.errHandle
+
+	// the element type without a value, unknown types like sql.NullInt64 are returned as strings then scanned
+	elemType := arrayElementType(destValue.Type().Elem().Elem())
+	switch elemType {
+	case typeInt64:
+		sbind.dataType = C.SQLT_INT
+		returning.maxSize = 8
+	case typeFloat64:
+		sbind.dataType = C.SQLT_BDOUBLE
+		returning.maxSize = 8
+	case typeBool:
+		nativeBool, err := stmt.nativeBool()
+		if err != nil {
+			C.free(unsafe.Pointer(returning))
+			return false, err
+		}
+		if nativeBool {
+			sbind.dataType = C.SQLT_BOL
+			returning.maxSize = C.sizeof_int
+		} else {
+			// handle as 0/1 int
+			sbind.dataType = C.SQLT_INT
+			returning.maxSize = 1
+		}
+	case typeNumber:
+		sbind.dataType = C.SQLT_VNU
+		returning.maxSize = 22
+	case typeSliceByte:
+		sbind.dataType = C.SQLT_BIN
+		returning.maxSize = 4000
+	case typeTime, typeDate, typeTimestamp, typeTimestampTZ:
+		sbind.dataType = stmt.conn.timeDataType(elemType)
+		switch sbind.dataType {
+		case C.SQLT_ODT:
+			returning.maxSize = C.sizeof_OCIDate
+		case C.SQLT_TIMESTAMP:
+			returning.maxSize = C.ub4(sizeOfNilPointer)
+			returning.descriptorType = C.OCI_DTYPE_TIMESTAMP
+		default:
+			returning.maxSize = C.ub4(sizeOfNilPointer)
+			returning.descriptorType = C.OCI_DTYPE_TIMESTAMP_TZ
+		}
+	default:
+		sbind.dataType = C.SQLT_CHR
+		returning.maxSize = 4000
+	}
+
+	C.free(unsafe.Pointer(sbind.length))
+	C.free(unsafe.Pointer(sbind.indicator))
+	sbind.length = nil
+	sbind.indicator = nil
+	sbind.pbuf = nil
+	sbind.maxSize = C.sb4(returning.maxSize)
+	sbind.out = out
+	sbind.returning = returning
+
+	return true, nil
+}
+
+// mode returns the bind mode, OCI_DATA_AT_EXEC for DML RETURNING binds otherwise OCI_DEFAULT
+func (bind *bindStruct) mode() C.ub4 {
+	if bind.returning != nil {
+		return C.OCI_DATA_AT_EXEC
+	}
+	return C.OCI_DEFAULT
+}
+
+// ociBindDynamic calls OCIBindDynamic to register the DML RETURNING callbacks of the bind
+func (stmt *Stmt) ociBindDynamic(bind *bindStruct) error {
+	result := C.oci8BindReturning(bind.bindHandle, stmt.conn.errHandle, bind.returning)
+	return stmt.conn.getError(result)
+}
+
+// outputReturningBind sets the out slice to the values returned by the DML RETURNING bind
+func (stmt *Stmt) outputReturningBind(bind *bindStruct) error {
+	destValue := reflect.ValueOf(bind.out.Dest).Elem()
+	returning := bind.returning
+	size := int(returning.rows)
+	slice := reflect.MakeSlice(destValue.Type(), size, size)
+
+	if size > 0 {
+		lengths := (*[1 << 27]C.ub4)(unsafe.Pointer(returning.lengths))[:size:size]
+		indicators := (*[1 << 27]C.sb2)(unsafe.Pointer(returning.indicators))[:size:size]
+		returnCodes := (*[1 << 27]C.ub2)(unsafe.Pointer(returning.returnCodes))[:size:size]
+
+		for i := 0; i < size; i++ {
+			if returnCodes[i] != 0 && indicators[i] != -1 {
+				return fmt.Errorf("element %v - error: ORA-%05d", i, returnCodes[i])
+			}
+
+			var value interface{}
+			if indicators[i] != -1 {
+				pbuf := unsafe.Pointer(uintptr(unsafe.Pointer(returning.buffer)) + uintptr(i)*uintptr(returning.maxSize))
+				var err error
+				value, err = stmt.conn.arrayElementToGo(bind.dataType, bind.maxSize, pbuf, int(lengths[i]))
+				if err != nil {
+					return fmt.Errorf("element %v - error: %v", i, err)
+				}
+			}
+
+			err := setArrayElement(slice.Index(i), value)
+			if err != nil {
+				return fmt.Errorf("element %v - error: %v", i, err)
+			}
+		}
+	}
+
+	destValue.Set(slice)
+
+	return nil
+}
+
+// freeReturning frees the DML RETURNING bind
+func freeReturning(returning *C.oci8Returning) {
+	C.oci8ReturningFree(returning)
+}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"io"
 	"reflect"
+	"strconv"
 	"time"
 	"unsafe"
 )
@@ -22,9 +23,40 @@ func (rows *Rows) Close() error {
 
 	rows.closed = true
 
+	// Lobs scanned into a Lob have no locator, so Close does nothing for them
+	var err error
+	for _, lob := range rows.lobs {
+		closeErr := lob.Close()
+		if err == nil {
+			err = closeErr
+		}
+	}
+	rows.lobs = nil
+
 	freeDefines(rows.defines)
 
-	return nil
+	if rows.freeHandle {
+		C.OCIHandleFree(unsafe.Pointer(rows.stmt.stmt), C.OCI_HTYPE_STMT)
+		rows.stmt.stmt = nil
+	}
+
+	return err
+}
+
+// keepTemporaryLobs moves the temporary LOBs created for the binds to the rows,
+// so the query can still read them while fetching. They are freed when the rows are closed.
+func (rows *Rows) keepTemporaryLobs(binds []bindStruct) {
+	for i := range binds {
+		if binds[i].lobConn == nil || binds[i].lob != nil || binds[i].pbuf == nil {
+			continue
+		}
+		locatorP := (**C.OCILobLocator)(binds[i].pbuf)
+		if *locatorP == nil {
+			continue
+		}
+		rows.lobs = append(rows.lobs, &Lob{conn: binds[i].lobConn, locator: *locatorP})
+		*locatorP = nil
+	}
 }
 
 // Columns returns column names
@@ -46,35 +78,49 @@ func (rows *Rows) Next(dest []driver.Value) error {
 		return rows.stmt.ctx.Err()
 	}
 
-	done := make(chan struct{})
-	defer close(done)
-	go rows.stmt.conn.ociBreakDone(rows.stmt.ctx, done)
-	result := C.OCIStmtFetch2(
-		rows.stmt.stmt,
-		rows.stmt.conn.errHandle,
-		1,
-		C.OCI_FETCH_NEXT,
-		0,
-		C.OCI_DEFAULT)
-	if result == C.OCI_NO_DATA {
-		return io.EOF
-	} else if result != C.OCI_SUCCESS && result != C.OCI_SUCCESS_WITH_INFO {
-		return rows.stmt.conn.getError(result)
+	if rows.currentRow+1 < rows.rowsFetched {
+		rows.currentRow++
+	} else {
+		if rows.fetchDone {
+			return io.EOF
+		}
+		err := rows.fetch()
+		if err != nil {
+			return err
+		}
+		if rows.rowsFetched < 1 {
+			return io.EOF
+		}
 	}
 
+	row := rows.currentRow
 	for i := range dest {
-		if *rows.defines[i].indicator == -1 { // Null
+		if rows.defines[i].dataType == C.SQLT_NTY {
+			// object types have a null structure instead of an indicator and are fetched one row at a time
+			var err error
+			dest[i], err = rows.defines[i].objectValue()
+			if err != nil {
+				return fmt.Errorf("column %s - error: %v", rows.defines[i].name, err)
+			}
+			continue
+		}
+
+		indicator := (*[1 << 27]C.sb2)(unsafe.Pointer(rows.defines[i].indicator))[row]
+		if indicator == -1 { // Null
 			dest[i] = nil
 			continue
-		} else if *rows.defines[i].indicator != 0 {
-			return fmt.Errorf("unknown indicator %d for column %s", *rows.defines[i].indicator, rows.defines[i].name)
+		} else if indicator != 0 {
+			return fmt.Errorf("unknown indicator %d for column %s", indicator, rows.defines[i].name)
 		}
 
+		length := (*[1 << 27]C.ub2)(unsafe.Pointer(rows.defines[i].length))[row]
+		pbuf := unsafe.Pointer(uintptr(rows.defines[i].pbuf) + uintptr(row)*uintptr(rows.defines[i].maxSize))
+
 		switch rows.defines[i].dataType {
 
 		// SQLT_DAT
 		case C.SQLT_DAT: // for test, date are return as timestamp
-			buf := (*[1 << 30]byte)(rows.defines[i].pbuf)[0:*rows.defines[i].length]
+			buf := (*[1 << 30]byte)(pbuf)[0:length]
 			// TODO: Handle BCE dates (http://docs.oracle.com/cd/B12037_01/appdev.101/b10779/oci03typ.htm#438305)
 			// TODO: Handle timezones (http://docs.oracle.com/cd/B12037_01/appdev.101/b10779/oci03typ.htm#443601)
 			dest[i] = time.Date(
@@ -87,10 +133,29 @@ func (rows *Rows) Next(dest []driver.Value) error {
 				0,
 				rows.stmt.conn.timeLocation)
 
+		// SQLT_FILE
+		case C.SQLT_FILE:
+			bfile, err := rows.stmt.conn.newBFile(*(**C.OCILobLocator)(pbuf))
+			if err != nil {
+				return fmt.Errorf("BFILE locator for column %v - error: %v", i, err)
+			}
+			rows.lobs = append(rows.lobs, bfile)
+			dest[i] = bfile
+
 		// SQLT_BLOB and SQLT_CLOB
 		case C.SQLT_BLOB, C.SQLT_CLOB:
-			lobLocator := (**C.OCILobLocator)(rows.defines[i].pbuf)
-			buffer, err := rows.stmt.conn.ociLobRead(*lobLocator, C.SQLCS_IMPLICIT)
+			lobLocator := (**C.OCILobLocator)(pbuf)
+			if rows.stmt.conn.lobLocator || rows.stmt.lobLocator {
+				lob, err := rows.stmt.conn.newLob(*lobLocator, rows.defines[i].dataType == C.SQLT_CLOB)
+				if err != nil {
+					return fmt.Errorf("LOB locator for column %v - error: %v", i, err)
+				}
+				rows.lobs = append(rows.lobs, lob)
+				dest[i] = lob
+				break
+			}
+
+			buffer, err := rows.stmt.conn.ociLobRead(*lobLocator, rows.defines[i].charsetForm)
 			if err != nil {
 				return err
 			}
@@ -104,26 +169,40 @@ func (rows *Rows) Next(dest []driver.Value) error {
 
 		// SQLT_CHR, SQLT_STR, SQLT_AFC, SQLT_AVC, and SQLT_LNG
 		case C.SQLT_CHR, C.SQLT_STR, C.SQLT_AFC, C.SQLT_AVC, C.SQLT_LNG:
-			dest[i] = C.GoStringN((*C.char)(rows.defines[i].pbuf), C.int(*rows.defines[i].length))
+			dest[i] = C.GoStringN((*C.char)(pbuf), C.int(length))
 
 		// SQLT_BIN
 		case C.SQLT_BIN: // RAW
-			buf := (*[1 << 30]byte)(rows.defines[i].pbuf)[0:*rows.defines[i].length]
+			buf := (*[1 << 30]byte)(pbuf)[0:length]
 			dest[i] = buf
 
 		// SQLT_NUM
 		case C.SQLT_NUM: // NUMBER
-			buf := (*[21]byte)(rows.defines[i].pbuf)[0:*rows.defines[i].length]
+			buf := (*[21]byte)(pbuf)[0:length]
 			dest[i] = buf
 
 		// SQLT_VNU
-		case C.SQLT_VNU: // VARNUM
-			buf := (*[22]byte)(rows.defines[i].pbuf)[0:*rows.defines[i].length]
-			dest[i] = buf
+		case C.SQLT_VNU: // VARNUM, the length byte followed by the NUMBER
+			buf := (*[22]byte)(pbuf)[0:length]
+			if length < 1 || int(buf[0]) >= int(length) {
+				return fmt.Errorf("invalid VARNUM length for column %v", i)
+			}
+			number, err := decodeNumber(buf[1 : 1+buf[0]])
+			if err != nil {
+				return fmt.Errorf("number for column %v - error: %v", i, err)
+			}
+			dest[i] = number
+			if rows.defines[i].bigInteger {
+				// int64 if it fits, otherwise the Number so it does not overflow
+				value, err := strconv.ParseInt(string(number), 10, 64)
+				if err == nil {
+					dest[i] = value
+				}
+			}
 
 		// SQLT_INT
 		case C.SQLT_INT: // INT
-			buf := (*[8]byte)(rows.defines[i].pbuf)[0:*rows.defines[i].length]
+			buf := (*[8]byte)(pbuf)[0:length]
 			var data int64
 			err := binary.Read(bytes.NewReader(buf), binary.LittleEndian, &data)
 			if err != nil {
@@ -131,9 +210,13 @@ func (rows *Rows) Next(dest []driver.Value) error {
 			}
 			dest[i] = data
 
+		// SQLT_BOL
+		case C.SQLT_BOL: // native boolean
+			dest[i] = *(*C.int)(pbuf) != 0
+
 		// SQLT_BDOUBLE
 		case C.SQLT_BDOUBLE: // native double
-			buf := (*[8]byte)(rows.defines[i].pbuf)[0:*rows.defines[i].length]
+			buf := (*[8]byte)(pbuf)[0:length]
 			var data float64
 			err := binary.Read(bytes.NewReader(buf), binary.LittleEndian, &data)
 			if err != nil {
@@ -143,7 +226,7 @@ func (rows *Rows) Next(dest []driver.Value) error {
 
 		// SQLT_TIMESTAMP
 		case C.SQLT_TIMESTAMP:
-			aTime, err := rows.stmt.conn.ociDateTimeToTime(*(**C.OCIDateTime)(rows.defines[i].pbuf), false)
+			aTime, err := rows.stmt.conn.ociDateTimeToTime(*(**C.OCIDateTime)(pbuf), false)
 			if err != nil {
 				return fmt.Errorf("ociDateTimeToTime for column %v - error: %v", i, err)
 			}
@@ -151,7 +234,7 @@ func (rows *Rows) Next(dest []driver.Value) error {
 
 		// SQLT_TIMESTAMP_TZ and SQLT_TIMESTAMP_LTZ
 		case C.SQLT_TIMESTAMP_TZ, C.SQLT_TIMESTAMP_LTZ:
-			aTime, err := rows.stmt.conn.ociDateTimeToTime(*(**C.OCIDateTime)(rows.defines[i].pbuf), true)
+			aTime, err := rows.stmt.conn.ociDateTimeToTime(*(**C.OCIDateTime)(pbuf), true)
 			if err != nil {
 				return fmt.Errorf("ociDateTimeToTime for column %v - error: %v", i, err)
 			}
@@ -159,60 +242,36 @@ func (rows *Rows) Next(dest []driver.Value) error {
 
 		// SQLT_INTERVAL_DS
 		case C.SQLT_INTERVAL_DS:
-			var days C.sb4
-			var hours C.sb4
-			var minutes C.sb4
-			var seconds C.sb4
-			var fracSeconds C.sb4
-			interval := *(**C.OCIInterval)(rows.defines[i].pbuf)
-			result = C.OCIIntervalGetDaySecond(
-				unsafe.Pointer(rows.stmt.conn.env), // environment handle
-				rows.stmt.conn.errHandle,           // error handle
-				&days,                              // days
-				&hours,                             // hours
-				&minutes,                           // minutes
-				&seconds,                           // seconds
-				&fracSeconds,                       // fractional seconds
-				interval,                           // interval
-			)
-			if result != C.OCI_SUCCESS {
-				return rows.stmt.conn.getError(result)
+			duration, err := rows.stmt.conn.ociIntervalToDuration(*(**C.OCIInterval)(pbuf))
+			if err != nil {
+				return err
 			}
-
-			dest[i] = (int64(days) * 24 * int64(time.Hour)) + (int64(hours) * int64(time.Hour)) +
-				(int64(minutes) * int64(time.Minute)) + (int64(seconds) * int64(time.Second)) + int64(fracSeconds)
+			dest[i] = IntervalDS(duration)
 
 		// SQLT_INTERVAL_YM
 		case C.SQLT_INTERVAL_YM:
-			var years C.sb4
-			var months C.sb4
-			interval := *(**C.OCIInterval)(rows.defines[i].pbuf)
-			result = C.OCIIntervalGetYearMonth(
-				unsafe.Pointer(rows.stmt.conn.env), // environment handle
-				rows.stmt.conn.errHandle,           // error handle
-				&years,                             // year
-				&months,                            // month
-				interval,                           // interval
-			)
-			if result != C.OCI_SUCCESS {
-				return rows.stmt.conn.getError(result)
+			months, err := rows.stmt.conn.ociIntervalToMonths(*(**C.OCIInterval)(pbuf))
+			if err != nil {
+				return err
 			}
-			dest[i] = (int64(years) * 12) + int64(months)
+			dest[i] = IntervalYM(months)
 
 		// SQLT_RSET - ref cursor
 		case C.SQLT_RSET:
-			stmtP := (**C.OCIStmt)(rows.defines[i].pbuf)
-			subStmt := &Stmt{conn: rows.stmt.conn, stmt: *stmtP, ctx: rows.stmt.ctx, releaseMode: C.ub4(C.OCI_DEFAULT)}
+			stmtP := (**C.OCIStmt)(pbuf)
+			subStmt := &Stmt{conn: rows.stmt.conn, stmt: *stmtP, ctx: rows.stmt.ctx, releaseMode: C.ub4(C.OCI_DEFAULT), exactNumber: rows.stmt.exactNumber,
+				lobLocator: rows.stmt.lobLocator, lobPrefetch: rows.stmt.lobPrefetch}
 			if rows.defines[i].subDefines == nil {
 				var err error
-				rows.defines[i].subDefines, err = subStmt.makeDefines()
+				rows.defines[i].subDefines, err = subStmt.makeDefines(1)
 				if err != nil {
 					return err
 				}
 			}
 			subRows := &Rows{
-				stmt:    subStmt,
-				defines: rows.defines[i].subDefines,
+				stmt:      subStmt,
+				defines:   rows.defines[i].subDefines,
+				arraySize: 1,
 			}
 			dest[i] = subRows
 
@@ -226,6 +285,108 @@ func (rows *Rows) Next(dest []driver.Value) error {
 	return nil
 }
 
+// fetch calls OCIStmtFetch2 to fetch the next array of rows into the defines
+func (rows *Rows) fetch() error {
+	arraySize := rows.arraySize
+	if arraySize < 1 {
+		arraySize = 1
+	}
+
+	done := make(chan struct{})
+	go rows.stmt.conn.ociBreakDone(rows.stmt.ctx, done)
+	result := C.OCIStmtFetch2(
+		rows.stmt.stmt,           // statement handle
+		rows.stmt.conn.errHandle, // error handle
+		C.ub4(arraySize),         // number of rows to be fetched from the current position
+		C.OCI_FETCH_NEXT,         // orientation
+		0,                        // fetch offset, only used with OCI_FETCH_ABSOLUTE and OCI_FETCH_RELATIVE
+		C.OCI_DEFAULT,            // mode
+	)
+	close(done)
+	if result == C.OCI_NO_DATA {
+		// the last fetch can return OCI_NO_DATA with rows when there are less rows than the array size
+		rows.fetchDone = true
+	} else if result != C.OCI_SUCCESS && result != C.OCI_SUCCESS_WITH_INFO {
+		return rows.stmt.conn.getError(result)
+	}
+
+	// OCI_ATTR_ROWS_FETCHED is the number of rows fetched into the user buffers by the last fetch
+	var rowsFetched C.ub4
+	_, err := rows.stmt.ociAttrGet(unsafe.Pointer(&rowsFetched), C.OCI_ATTR_ROWS_FETCHED)
+	if err != nil {
+		return err
+	}
+
+	rows.rowsFetched = int(rowsFetched)
+	rows.currentRow = 0
+
+	return nil
+}
+
+// HasNextResultSet implements driver.RowsNextResultSet.
+// Returns true if the PL/SQL statement returned another implicit result set.
+func (rows *Rows) HasNextResultSet() bool {
+	return rows.implicitStmt != nil && rows.implicitResultIndex < rows.implicitResultCount
+}
+
+// NextResultSet implements driver.RowsNextResultSet.
+// Advances to the next implicit result set, returns io.EOF if there are no more result sets.
+func (rows *Rows) NextResultSet() error {
+	if rows.closed || !rows.HasNextResultSet() {
+		return io.EOF
+	}
+
+	freeDefines(rows.defines)
+	rows.defines = nil
+
+	return rows.nextImplicitResult()
+}
+
+// nextImplicitResult calls OCIStmtGetNextResult and makes the defines for the next implicit result set.
+// The statement handle of the result set is freed when implicitStmt is released.
+func (rows *Rows) nextImplicitResult() error {
+	conn := rows.implicitStmt.conn
+	var result unsafe.Pointer
+	var resultType C.ub4
+	rv := C.OCIStmtGetNextResult(
+		rows.implicitStmt.stmt, // statement handle
+		conn.errHandle,         // error handle
+		&result,                // the statement handle of the next result set
+		&resultType,            // type of the result set, only OCI_RESULT_TYPE_SELECT is supported
+		C.OCI_DEFAULT,          // mode
+	)
+	if rv == C.OCI_NO_DATA {
+		rows.implicitResultIndex = rows.implicitResultCount
+		return io.EOF
+	}
+	err := conn.getError(rv)
+	if err != nil {
+		return err
+	}
+	if resultType != C.OCI_RESULT_TYPE_SELECT {
+		return fmt.Errorf("unsupported implicit result type %v", resultType)
+	}
+
+	rows.implicitResultIndex++
+	rows.stmt = &Stmt{conn: conn, stmt: (*C.OCIStmt)(result), ctx: rows.implicitStmt.ctx, releaseMode: C.ub4(C.OCI_DEFAULT),
+		exactNumber: rows.implicitStmt.exactNumber, lobLocator: rows.implicitStmt.lobLocator,
+		lobPrefetch: rows.implicitStmt.lobPrefetch}
+	rows.defines, err = rows.stmt.makeDefines(rows.implicitArraySize)
+	if err != nil {
+		return err
+	}
+
+	rows.arraySize = 1
+	if len(rows.defines) > 0 {
+		rows.arraySize = rows.defines[0].arraySize
+	}
+	rows.rowsFetched = 0
+	rows.currentRow = 0
+	rows.fetchDone = false
+
+	return nil
+}
+
 // ColumnTypeDatabaseTypeName implement RowsColumnTypeDatabaseTypeName.
 func (rows *Rows) ColumnTypeDatabaseTypeName(i int) string {
 	if len(rows.defines) < i+1 {
@@ -299,6 +460,8 @@ func (rows *Rows) ColumnTypeDatabaseTypeName(i int) string {
 		return "SQLT_INTERVAL_DS"
 	case C.SQLT_TIMESTAMP_LTZ:
 		return "SQLT_TIMESTAMP_LTZ"
+	case C.SQLT_BOL:
+		return "SQLT_BOL"
 	}
 	return ""
 }
@@ -324,9 +487,19 @@ func (rows *Rows) ColumnTypeScanType(i int) reflect.Type {
 	}
 
 	switch rows.defines[i].dataType {
-	case C.SQLT_AFC, C.SQLT_CHR, C.SQLT_VCS, C.SQLT_AVC, C.SQLT_CLOB, C.SQLT_RDD:
+	case C.SQLT_CLOB, C.SQLT_BLOB:
+		if rows.stmt.conn.lobLocator || rows.stmt.lobLocator {
+			return typeLob
+		}
+		if rows.defines[i].dataType == C.SQLT_CLOB {
+			return typeString
+		}
+		return typeSliceByte
+	case C.SQLT_FILE:
+		return typeBFile
+	case C.SQLT_AFC, C.SQLT_CHR, C.SQLT_VCS, C.SQLT_AVC, C.SQLT_RDD:
 		return typeString
-	case C.SQLT_BIN, C.SQLT_BLOB:
+	case C.SQLT_BIN:
 		return typeSliceByte
 	case C.SQLT_INT:
 		return typeInt64
@@ -334,8 +507,18 @@ func (rows *Rows) ColumnTypeScanType(i int) reflect.Type {
 		return typeFloat64
 	case C.SQLT_TIMESTAMP, C.SQLT_DAT, C.SQLT_TIMESTAMP_TZ, C.SQLT_TIMESTAMP_LTZ:
 		return typeTime
-	case C.SQLT_INTERVAL_DS, C.SQLT_INTERVAL_YM:
-		return typeInt64
+	case C.SQLT_INTERVAL_DS:
+		return typeIntervalDS
+	case C.SQLT_INTERVAL_YM:
+		return typeIntervalYM
+	case C.SQLT_BOL:
+		return typeBool
+	case C.SQLT_VNU:
+		// Next returns a Number for the values that do not fit in an int64
+		if rows.defines[i].bigInteger {
+			return typeInt64
+		}
+		return typeNumber
 	}
 
 	return typeNil
@@ -9,7 +9,11 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	"encoding/binary"
+	"errors"
 	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
 	"strings"
 	"time"
 	"unsafe"
@@ -49,22 +53,182 @@ func (stmt *Stmt) Close() error {
 	return stmt.conn.getError(result)
 }
 
-// NumInput returns the number of input
+// NumInput returns the number of input.
+// A bind name used more than once counts as one input.
+// Returns -1 if the bind info could not be read.
 func (stmt *Stmt) NumInput() int {
+	return stmt.numInput
+}
+
+// getBindInfo sets numInput, bindNames, and repeatBinds from OCI_ATTR_BIND_COUNT and OCIStmtGetBindInfo.
+// If the bind info can not be read, numInput is set to -1 so database/sql does not check the number of arguments.
+func (stmt *Stmt) getBindInfo() {
+	stmt.numInput = -1
+
+	var bindCount C.ub4
+	_, err := stmt.ociAttrGet(unsafe.Pointer(&bindCount), C.OCI_ATTR_BIND_COUNT)
+	if err != nil {
+		return
+	}
+	if bindCount < 1 {
+		stmt.numInput = 0
+		return
+	}
+
+	names := make([]*C.OraText, bindCount)
+	nameLengths := make([]C.ub1, bindCount)
+	indicatorNames := make([]*C.OraText, bindCount)
+	indicatorNameLengths := make([]C.ub1, bindCount)
+	duplicates := make([]C.ub1, bindCount)
+	bindHandles := make([]*C.OCIBind, bindCount)
+	var found C.sb4
+
+	result := C.OCIStmtGetBindInfo(
+		stmt.stmt,                // The statement handle prepared by OCIStmtPrepare2()
+		stmt.conn.errHandle,      // An error handle
+		bindCount,                // The number of elements in each array
+		1,                        // Position of the bind variable at which to start getting bind information
+		&found,                   // The number of bind variables found. Negative if there are more than size.
+		&names[0],                // Array of pointers to bind variable names
+		&nameLengths[0],          // Array of lengths of bind variable names
+		&indicatorNames[0],       // Array of pointers to indicator variable names
+		&indicatorNameLengths[0], // Array of lengths of indicator variable names
+		&duplicates[0],           // Array of flags set to 1 if the bind variable name is a duplicate of a previous one
+		&bindHandles[0],          // Array of bind handles, if the bind variable has been bound
+	)
+	if result == C.OCI_NO_DATA {
+		stmt.numInput = 0
+		return
+	}
+	if result != C.OCI_SUCCESS || found < 0 || C.ub4(found) > bindCount {
+		return
+	}
+
+	stmt.bindNames = make([]string, 0, found)
+	for i := 0; i < int(found); i++ {
+		name := cGoStringN(names[i], int(nameLengths[i]))
+		if duplicates[i] != 0 || stmt.bindNameIndex(name) >= 0 {
+			stmt.repeatBinds = true
+			continue
+		}
+		stmt.bindNames = append(stmt.bindNames, name)
+	}
+	stmt.numInput = len(stmt.bindNames)
+}
+
+// bindNameIndex returns the index of the bind name in bindNames, or -1 if not found
+func (stmt *Stmt) bindNameIndex(name string) int {
+	for i := 0; i < len(stmt.bindNames); i++ {
+		if strings.EqualFold(stmt.bindNames[i], name) {
+			return i
+		}
+	}
 	return -1
 }
 
-// CheckNamedValue checks a named value
+// bindPlaceholders returns the placeholder to bind each value by name, or nil to bind the value by position.
+// Named values are bound by name. Positional values are bound by their ordinal.
+// When a SQL statement uses a bind name more than once, positional values are bound by name so every use of the name gets the value.
+func (stmt *Stmt) bindPlaceholders(positions []int, namedValues []driver.NamedValue) ([][]byte, error) {
+	placeholders := make([][]byte, len(positions))
+
+	namedPositions := make(map[string]int, len(namedValues))
+	for i := 0; i < len(namedValues); i++ {
+		name := namedValues[i].Name
+		if len(name) < 1 {
+			continue
+		}
+		upperName := strings.ToUpper(name)
+		if _, found := namedPositions[upperName]; found {
+			return nil, fmt.Errorf("bind name %v is given more than once", name)
+		}
+		if stmt.numInput >= 0 && stmt.bindNameIndex(name) < 0 {
+			return nil, fmt.Errorf("bind name %v not found in statement", name)
+		}
+		namedPositions[upperName] = positions[i]
+		placeholders[i] = []byte(":" + name)
+	}
+
+	if stmt.numInput < 0 {
+		return placeholders, nil
+	}
+
+	for i := 0; i < len(positions); i++ {
+		if placeholders[i] != nil {
+			continue
+		}
+		if positions[i] > len(stmt.bindNames) {
+			return nil, fmt.Errorf("bind position %v is greater than the number of binds %v", positions[i], len(stmt.bindNames))
+		}
+		name := stmt.bindNames[positions[i]-1]
+		if position, found := namedPositions[strings.ToUpper(name)]; found {
+			return nil, fmt.Errorf("bind %v is given by position %v and by name at position %v", name, positions[i], position)
+		}
+		if stmt.repeatBinds {
+			placeholders[i] = []byte(":" + name)
+		}
+	}
+
+	return placeholders, nil
+}
+
+// CheckNamedValue checks a named value.
+// The StmtOptions are cleared if a value fails, since the Exec or Query that would take them does not run.
 func (stmt *Stmt) CheckNamedValue(namedValue *driver.NamedValue) error {
-	switch namedValue.Value.(type) {
-	case sql.Out:
+	err := stmt.checkNamedValue(namedValue)
+	if err != nil && err != driver.ErrRemoveArgument {
+		stmt.options = stmtOptions{}
+	}
+	return err
+}
+
+// checkNamedValue checks a named value, values without a driver specific bind are converted with the default converter
+func (stmt *Stmt) checkNamedValue(namedValue *driver.NamedValue) error {
+	var err error
+	switch value := namedValue.Value.(type) {
+	case StmtOption:
+		value.apply(&stmt.options)
+		return driver.ErrRemoveArgument
+	case sql.Out, *Object, Object, *Collection, Collection, Number, IntervalDS, IntervalYM, Date, Timestamp, TimestampTZ, *Lob, *BFile, NString:
+		return nil
+	case uint, uint64, uintptr, *big.Int:
+		// the default converter fails for unsigned values greater than max int64
+		return nil
+	case nullValue:
+		// the value is bound as its Oracle type, not the driver.Value of the Valuer
+		namedValue.Value = value.bindValue()
+		return stmt.checkNamedValue(namedValue)
+	case []byte, driver.Valuer:
+		namedValue.Value, err = driver.DefaultParameterConverter.ConvertValue(namedValue.Value)
+		return err
+	}
+	if isObjectBindType(reflect.TypeOf(namedValue.Value)) {
+		// registered struct and slice types are bound as objects and collections
 		return nil
 	}
-	return driver.ErrSkip
+	if isArrayBindType(reflect.TypeOf(namedValue.Value)) {
+		// slices are bound as arrays for array DML
+		return nil
+	}
+	namedValue.Value, err = driver.DefaultParameterConverter.ConvertValue(namedValue.Value)
+	return err
+}
+
+// takeOptions returns the StmtOptions passed to the running Exec or Query and clears them,
+// so they do not apply to a later execution of the statement even if this one fails
+func (stmt *Stmt) takeOptions() stmtOptions {
+	options := stmt.options
+	stmt.options = stmtOptions{}
+	return options
+}
+
+// isArrayBindType returns true if the type is a slice that is bound as an array
+func isArrayBindType(valueType reflect.Type) bool {
+	return valueType != nil && valueType.Kind() == reflect.Slice && valueType.Elem().Kind() != reflect.Uint8
 }
 
 // bindValues binds the values to the stmt
-func (stmt *Stmt) bindValues(values []driver.Value, namedValues []driver.NamedValue) ([]bindStruct, error) {
+func (stmt *Stmt) bindValues(values []driver.Value, namedValues []driver.NamedValue, options *stmtOptions) ([]bindStruct, error) {
 	if len(values) == 0 && len(namedValues) == 0 {
 		return nil, nil
 	}
@@ -78,6 +242,20 @@ func (stmt *Stmt) bindValues(values []driver.Value, namedValues []driver.NamedVa
 		count = len(values)
 	}
 
+	positions := make([]int, count)
+	for i := 0; i < count; i++ {
+		if useValues || namedValues[i].Ordinal < 1 {
+			positions[i] = i + 1
+		} else {
+			positions[i] = namedValues[i].Ordinal
+		}
+	}
+	var placeholders [][]byte
+	placeholders, err = stmt.bindPlaceholders(positions, namedValues)
+	if err != nil {
+		return nil, err
+	}
+
 	for i := 0; i < count; i++ {
 		if stmt.ctx.Err() != nil {
 			freeBinds(binds)
@@ -96,16 +274,139 @@ func (stmt *Stmt) bindValues(values []driver.Value, namedValues []driver.NamedVa
 		} else {
 			valueInterface = namedValues[i].Value
 		}
+		if !useValues && namedValues[i].Name != "" {
+			sbind.param = namedValues[i].Name
+		} else {
+			sbind.param = strconv.Itoa(positions[i])
+		}
+
+		var isObject bool
+		isObject, err = stmt.makeObjectBind(&sbind, valueInterface)
+		if err != nil {
+			binds = append(binds, sbind)
+			freeBinds(binds)
+			return nil, fmt.Errorf("object bind for column %v - error: %v", i, err)
+		}
+		if isObject {
+			binds = append(binds, sbind)
+			err = stmt.bind(placeholders[i], positions[i], &sbind)
+			if err == nil {
+				err = stmt.ociBindObject(&sbind)
+			}
+			if err != nil {
+				freeBinds(binds)
+				return nil, err
+			}
+			continue
+		}
+
+		var isCursor bool
+		isCursor, err = stmt.makeCursorBind(&sbind, valueInterface)
+		if err != nil {
+			binds = append(binds, sbind)
+			freeBinds(binds)
+			return nil, fmt.Errorf("ref cursor bind for column %v - error: %v", i, err)
+		}
+		if isCursor {
+			binds = append(binds, sbind)
+			err = stmt.bind(placeholders[i], positions[i], &sbind)
+			if err != nil {
+				freeBinds(binds)
+				return nil, err
+			}
+			continue
+		}
+
+		var isReturning bool
+		isReturning, err = stmt.makeReturningBind(&sbind, valueInterface)
+		if err != nil {
+			binds = append(binds, sbind)
+			freeBinds(binds)
+			return nil, fmt.Errorf("returning bind for column %v - error: %v", i, err)
+		}
+		if isReturning {
+			binds = append(binds, sbind)
+			err = stmt.bind(placeholders[i], positions[i], &sbind)
+			if err == nil {
+				err = stmt.ociBindDynamic(&sbind)
+			}
+			if err != nil {
+				freeBinds(binds)
+				return nil, err
+			}
+			continue
+		}
+
+		var isPlsqlArray bool
+		isPlsqlArray, err = stmt.makePlsqlArrayBind(&sbind, valueInterface)
+		if err != nil {
+			binds = append(binds, sbind)
+			freeBinds(binds)
+			return nil, fmt.Errorf("PL/SQL array bind for column %v - error: %v", i, err)
+		}
+		if isPlsqlArray {
+			binds = append(binds, sbind)
+			err = stmt.bind(placeholders[i], positions[i], &sbind)
+			if err != nil {
+				freeBinds(binds)
+				return nil, err
+			}
+			continue
+		}
 
 		var isOut bool
 		var isNill bool
 		sbind.out, isOut = valueInterface.(sql.Out)
 		if isOut {
-			valueInterface, err = driver.DefaultParameterConverter.ConvertValue(sbind.out.Dest)
-			if err != nil {
+			if !isOutBindDest(sbind.out.Dest) {
 				binds = append(binds, sbind)
 				freeBinds(binds)
-				return nil, err
+				return nil, fmt.Errorf("out bind for column %v - error: unsupported destination type %T", i, sbind.out.Dest)
+			}
+			if isNilValue(sbind.out.Dest) {
+				binds = append(binds, sbind)
+				freeBinds(binds)
+				return nil, fmt.Errorf("out bind for column %v - error: destination is a nil pointer", i)
+			}
+			// the converter would convert these to int64, string, and []byte, or fail for large unsigned values, so they are bound by their own type
+			switch dest := sbind.out.Dest.(type) {
+			case *time.Duration:
+				valueInterface = *dest
+			case *IntervalDS:
+				valueInterface = *dest
+			case *IntervalYM:
+				valueInterface = *dest
+			case *Date:
+				valueInterface = *dest
+			case *Timestamp:
+				valueInterface = *dest
+			case *TimestampTZ:
+				valueInterface = *dest
+			case *Number:
+				valueInterface = *dest
+			case *uint:
+				valueInterface = *dest
+			case *uint64:
+				valueInterface = *dest
+			case *uintptr:
+				valueInterface = *dest
+			case *big.Int:
+				valueInterface = dest
+			case *Clob:
+				valueInterface = *dest
+			case *Blob:
+				valueInterface = *dest
+			case *Lob:
+				valueInterface = dest
+			case *NString:
+				valueInterface = *dest
+			default:
+				valueInterface, err = driver.DefaultParameterConverter.ConvertValue(sbind.out.Dest)
+				if err != nil {
+					binds = append(binds, sbind)
+					freeBinds(binds)
+					return nil, err
+				}
 			}
 			switch valueInterface.(type) {
 			case nil:
@@ -120,6 +421,8 @@ func (stmt *Stmt) bindValues(values []driver.Value, namedValues []driver.NamedVa
 					valueInterface = int64(0)
 				case *sql.NullString:
 					valueInterface = ""
+				case *sql.NullTime:
+					valueInterface = time.Time{}
 				}
 			}
 		}
@@ -132,262 +435,1019 @@ func (stmt *Stmt) bindValues(values []driver.Value, namedValues []driver.NamedVa
 			sbind.maxSize = 0
 			*sbind.indicator = -1 // set to null
 
-		case []byte:
-			if isOut {
+		case []byte:
+			if isOut {
+
+				if len(value) > 32767 {
+					err = stmt.makeLobBind(&sbind, C.SQLT_BLOB, value)
+					if err != nil {
+						binds = append(binds, sbind)
+						freeBinds(binds)
+						return nil, err
+					}
+				} else {
+					size := outBindSize(options.outSize, len(value))
+					sbind.dataType = C.SQLT_BIN
+					sbind.pbuf = unsafe.Pointer(cByteN(value, size+1))
+					sbind.maxSize = C.sb4(size)
+					if sbind.out.In && !isNill {
+						*sbind.length = C.ub2(len(value))
+					} else {
+						*sbind.indicator = -1 // set to null
+					}
+				}
+
+			} else {
+
+				if len(value) > 32767 {
+					err = stmt.makeLobBind(&sbind, C.SQLT_BLOB, value)
+					if err != nil {
+						binds = append(binds, sbind)
+						freeBinds(binds)
+						return nil, err
+					}
+				} else {
+					sbind.dataType = C.SQLT_BIN
+					sbind.pbuf = unsafe.Pointer(cByte(value))
+					sbind.maxSize = C.sb4(len(value))
+					*sbind.length = C.ub2(len(value))
+				}
+
+			}
+
+		case time.Time:
+			err = stmt.makeTimeBind(&sbind, stmt.conn.timeBind, value)
+			if err != nil {
+				freeBinds(binds)
+				return nil, fmt.Errorf("time for column %v - error: %v", i, err)
+			}
+			if isOut && sbind.out.In && isNill {
+				*sbind.indicator = -1 // set to null
+			}
+
+		case Date:
+			err = stmt.makeTimeBind(&sbind, C.SQLT_ODT, time.Time(value))
+			if err != nil {
+				freeBinds(binds)
+				return nil, fmt.Errorf("date for column %v - error: %v", i, err)
+			}
+
+		case Timestamp:
+			err = stmt.makeTimeBind(&sbind, C.SQLT_TIMESTAMP, time.Time(value))
+			if err != nil {
+				freeBinds(binds)
+				return nil, fmt.Errorf("timestamp for column %v - error: %v", i, err)
+			}
+
+		case TimestampTZ:
+			err = stmt.makeTimeBind(&sbind, C.SQLT_TIMESTAMP_TZ, time.Time(value))
+			if err != nil {
+				freeBinds(binds)
+				return nil, fmt.Errorf("timestamp for column %v - error: %v", i, err)
+			}
+
+		case time.Duration: // only out binds, otherwise converted to int64
+			sbind.dataType = C.SQLT_INTERVAL_DS
+			sbind.maxSize = C.sb4(sizeOfNilPointer)
+			*sbind.length = C.ub2(sizeOfNilPointer)
+
+			intervalPP, err := stmt.conn.durationToOCIInterval(value)
+			if err != nil {
+				freeBinds(binds)
+				return nil, fmt.Errorf("durationToOCIInterval for column %v - error: %v", i, err)
+			}
+
+			sbind.pbuf = unsafe.Pointer(intervalPP)
+
+		case IntervalDS:
+			sbind.dataType = C.SQLT_INTERVAL_DS
+			sbind.maxSize = C.sb4(sizeOfNilPointer)
+			*sbind.length = C.ub2(sizeOfNilPointer)
+
+			intervalPP, err := stmt.conn.durationToOCIInterval(time.Duration(value))
+			if err != nil {
+				freeBinds(binds)
+				return nil, fmt.Errorf("durationToOCIInterval for column %v - error: %v", i, err)
+			}
+
+			sbind.pbuf = unsafe.Pointer(intervalPP)
+
+		case IntervalYM:
+			sbind.dataType = C.SQLT_INTERVAL_YM
+			sbind.maxSize = C.sb4(sizeOfNilPointer)
+			*sbind.length = C.ub2(sizeOfNilPointer)
+
+			intervalPP, err := stmt.conn.monthsToOCIInterval(int64(value))
+			if err != nil {
+				freeBinds(binds)
+				return nil, fmt.Errorf("monthsToOCIInterval for column %v - error: %v", i, err)
+			}
+
+			sbind.pbuf = unsafe.Pointer(intervalPP)
+
+		case Number:
+			err = makeNumberBind(&sbind, string(value))
+			if err != nil {
+				freeBinds(binds)
+				return nil, fmt.Errorf("number for column %v - error: %v", i, err)
+			}
+
+		case uint, uint64, uintptr: // bound as NUMBER so values greater than max int64 do not overflow
+			err = makeNumberBind(&sbind, fmt.Sprint(value))
+			if err != nil {
+				freeBinds(binds)
+				return nil, fmt.Errorf("number for column %v - error: %v", i, err)
+			}
+
+		case *big.Int:
+			if value == nil {
+				sbind.dataType = C.SQLT_AFC
+				sbind.pbuf = nil
+				sbind.maxSize = 0
+				*sbind.indicator = -1 // set to null
+				break
+			}
+			err = makeNumberBind(&sbind, value.String())
+			if err != nil {
+				freeBinds(binds)
+				return nil, fmt.Errorf("number for column %v - error: %v", i, err)
+			}
+
+		case Clob: // only out binds, otherwise converted to string
+			err = stmt.makeLobBind(&sbind, C.SQLT_CLOB, []byte(value))
+			if err != nil {
+				binds = append(binds, sbind)
+				freeBinds(binds)
+				return nil, fmt.Errorf("CLOB for column %v - error: %v", i, err)
+			}
+
+		case Blob: // only out binds, otherwise converted to []byte
+			err = stmt.makeLobBind(&sbind, C.SQLT_BLOB, value)
+			if err != nil {
+				binds = append(binds, sbind)
+				freeBinds(binds)
+				return nil, fmt.Errorf("BLOB for column %v - error: %v", i, err)
+			}
+
+		case *Lob:
+			err = stmt.makeLobValueBind(&sbind, value, isOut)
+			if err != nil {
+				binds = append(binds, sbind)
+				freeBinds(binds)
+				return nil, fmt.Errorf("LOB for column %v - error: %v", i, err)
+			}
+
+		case *BFile:
+			err = stmt.makeBFileBind(&sbind, value)
+			if err != nil {
+				binds = append(binds, sbind)
+				freeBinds(binds)
+				return nil, fmt.Errorf("BFILE for column %v - error: %v", i, err)
+			}
+
+		case string:
+			err = stmt.makeStringBind(&sbind, value, isOut, isNill, options.outSize)
+			if err != nil {
+				binds = append(binds, sbind)
+				freeBinds(binds)
+				return nil, err
+			}
+
+		case NString:
+			sbind.nchar = true
+			err = stmt.makeStringBind(&sbind, string(value), isOut, isNill, options.outSize)
+			if err != nil {
+				binds = append(binds, sbind)
+				freeBinds(binds)
+				return nil, err
+			}
+
+		case int, int8, int16, int32, int64, uint8, uint16, uint32:
+			buffer := bytes.Buffer{}
+			err = binary.Write(&buffer, binary.LittleEndian, value)
+			if err != nil {
+				freeBinds(binds)
+				return nil, fmt.Errorf("binary read for column %v - error: %v", i, err)
+			}
+			sbind.dataType = C.SQLT_INT
+			sbind.pbuf = unsafe.Pointer(cByte(buffer.Bytes()))
+			sbind.maxSize = C.sb4(buffer.Len())
+			*sbind.length = C.ub2(buffer.Len())
+			if isOut && sbind.out.In && isNill {
+				*sbind.indicator = -1 // set to null
+			}
+
+		case float32, float64:
+			buffer := bytes.Buffer{}
+			err = binary.Write(&buffer, binary.LittleEndian, value)
+			if err != nil {
+				freeBinds(binds)
+				return nil, fmt.Errorf("binary read for column %v - error: %v", i, err)
+			}
+			sbind.dataType = C.SQLT_BDOUBLE
+			sbind.pbuf = unsafe.Pointer(cByte(buffer.Bytes()))
+			sbind.maxSize = C.sb4(buffer.Len())
+			*sbind.length = C.ub2(buffer.Len())
+			if isOut && sbind.out.In && isNill {
+				*sbind.indicator = -1 // set to null
+			}
+
+		case bool:
+			var nativeBool bool
+			nativeBool, err = stmt.nativeBool()
+			if err != nil {
+				freeBinds(binds)
+				return nil, err
+			}
+			if nativeBool {
+				sbind.dataType = C.SQLT_BOL
+				sbind.pbuf = C.malloc(C.sizeof_int)
+				*(*C.int)(sbind.pbuf) = 0
+				if value {
+					*(*C.int)(sbind.pbuf) = 1
+				}
+				sbind.maxSize = C.sizeof_int
+				*sbind.length = C.sizeof_int
+			} else {
+				// handle as 0/1 int
+				sbind.dataType = C.SQLT_INT
+				if value {
+					sbind.pbuf = unsafe.Pointer(cByte([]byte{1}))
+				} else {
+					sbind.pbuf = unsafe.Pointer(cByte([]byte{0}))
+				}
+				sbind.maxSize = 1
+				*sbind.length = 1
+			}
+			if isOut && sbind.out.In && isNill {
+				*sbind.indicator = -1 // set to null
+			}
+
+		default:
+			if isOut {
+				binds = append(binds, sbind)
+				freeBinds(binds)
+				return nil, fmt.Errorf("out bind for column %v - error: unsupported destination type %T", i, sbind.out.Dest)
+			} else if isArrayBindType(reflect.TypeOf(value)) {
+				err = stmt.makeArrayBind(&sbind, reflect.ValueOf(value), reflect.ValueOf(value).Len(), 1)
+				if err != nil {
+					binds = append(binds, sbind)
+					freeBinds(binds)
+					return nil, fmt.Errorf("array bind for column %v - error: %v", i, err)
+				}
+			} else {
+				d := fmt.Sprintf("%v", value)
+				sbind.dataType = C.SQLT_AFC
+				sbind.pbuf = unsafe.Pointer(C.CString(d))
+				sbind.maxSize = C.sb4(len(d))
+				*sbind.length = C.ub2(len(d))
+			}
+		}
+
+		// add to binds now so if error will be freed by freeBinds call
+		binds = append(binds, sbind)
+
+		err = stmt.bind(placeholders[i], positions[i], &sbind)
+		if err != nil {
+			freeBinds(binds)
+			return nil, err
+		}
+
+	}
+
+	return binds, nil
+}
+
+// bind binds sbind by name if placeholder is not nil, otherwise by position.
+// A national character set bind that is not a LOB has OCI_ATTR_CHARSET_FORM set to SQLCS_NCHAR.
+func (stmt *Stmt) bind(placeholder []byte, position int, sbind *bindStruct) error {
+	var err error
+	if placeholder == nil {
+		err = stmt.ociBindByPos(C.ub4(position), sbind)
+	} else {
+		err = stmt.ociBindByName(placeholder, sbind)
+	}
+	if err != nil || !sbind.nchar || sbind.dataType == C.SQLT_CLOB {
+		return err
+	}
+
+	charsetForm := C.ub1(C.SQLCS_NCHAR)
+	return stmt.conn.ociAttrSet(unsafe.Pointer(sbind.bindHandle), C.OCI_HTYPE_BIND, unsafe.Pointer(&charsetForm), 0, C.OCI_ATTR_CHARSET_FORM)
+}
+
+// charsetForm returns the character set form of the bind: SQLCS_NCHAR for a national character set bind, otherwise SQLCS_IMPLICIT
+func (bind *bindStruct) charsetForm() C.ub1 {
+	if bind.nchar {
+		return C.SQLCS_NCHAR
+	}
+	return C.SQLCS_IMPLICIT
+}
+
+// makeLobBind fills sbind with a temporary LOB of dataType SQLT_CLOB or SQLT_BLOB containing value.
+// A CLOB is an NCLOB if sbind.nchar is set.
+func (stmt *Stmt) makeLobBind(sbind *bindStruct, dataType C.ub2, value []byte) error {
+	lobP, _, err := stmt.conn.ociDescriptorAlloc(C.OCI_DTYPE_LOB, 0)
+	if err != nil {
+		return err
+	}
+	sbind.dataType = dataType
+	sbind.pbuf = unsafe.Pointer(lobP)
+	sbind.maxSize = C.sb4(sizeOfNilPointer)
+	*sbind.length = C.ub2(sizeOfNilPointer)
+
+	lobType := C.ub1(C.OCI_TEMP_BLOB)
+	if dataType == C.SQLT_CLOB {
+		lobType = C.OCI_TEMP_CLOB
+	}
+	lobLocator := (**C.OCILobLocator)(sbind.pbuf)
+	err = stmt.conn.ociLobCreateTemporary(*lobLocator, sbind.charsetForm(), lobType)
+	if err != nil {
+		return err
+	}
+	sbind.lobConn = stmt.conn
+	if len(value) == 0 {
+		return nil
+	}
+
+	return stmt.conn.ociLobWrite(*lobLocator, sbind.charsetForm(), value)
+}
+
+// makeLobValueBind fills sbind with the locator of the Lob, or a temporary LOB for the content of a Lob made with NewBlob or NewClob.
+// An out bind Lob without a locator is bound with a new locator that is moved to the Lob by outputBoundParameters.
+// An in bind Lob without a locator or reader is a null.
+func (stmt *Stmt) makeLobValueBind(sbind *bindStruct, lob *Lob, isOut bool) error {
+	dataType := C.ub2(C.SQLT_BLOB)
+	if lob.isClob {
+		dataType = C.SQLT_CLOB
+	}
+
+	if lob.locator != nil {
+		if lob.conn != stmt.conn {
+			return errors.New("lob is from a different connection")
+		}
+		sbind.dataType = dataType
+		sbind.pbuf = unsafe.Pointer(&lob.locator)
+		sbind.maxSize = C.sb4(sizeOfNilPointer)
+		*sbind.length = C.ub2(sizeOfNilPointer)
+		sbind.lob = lob
+		return nil
+	}
+
+	sbind.nchar = lob.form == C.SQLCS_NCHAR
+	err := stmt.makeLobBind(sbind, dataType, nil)
+	if err != nil {
+		return err
+	}
+	if lob.reader != nil {
+		// the reader is read by writeLobReaders once all the values are bound
+		sbind.lobReader = lob.reader
+		return nil
+	}
+	if !isOut {
+		*sbind.indicator = -1 // set to null
+	}
+	return nil
+}
+
+// writeLobReaders writes the content of the NewBlob, NewClob, and NewNClob readers to their temporary LOBs.
+// Called just before execute, so a reader is not read when binding or the statement fails before it runs.
+func (stmt *Stmt) writeLobReaders(binds []bindStruct) error {
+	for i := range binds {
+		if binds[i].lobReader == nil {
+			continue
+		}
+		form := binds[i].charsetForm()
+		temporary := &Lob{conn: stmt.conn, locator: *(**C.OCILobLocator)(binds[i].pbuf), isClob: binds[i].dataType == C.SQLT_CLOB, form: form}
+		_, err := temporary.ReadFrom(binds[i].lobReader)
+		if err != nil {
+			return fmt.Errorf("LOB for column %v - error: %v", i, err)
+		}
+	}
+	return nil
+}
+
+// makeStringBind fills sbind with a character bind of value, or a temporary CLOB if value is longer than 32767 bytes.
+// An out bind is null unless sbind.out.In is set and the value is not nil, outSize is the OutSize option.
+func (stmt *Stmt) makeStringBind(sbind *bindStruct, value string, isOut bool, isNill bool, outSize int) error {
+	if len(value) > 32767 {
+		return stmt.makeLobBind(sbind, C.SQLT_CLOB, []byte(value))
+	}
+
+	if isOut {
+		size := outBindSize(outSize, len(value))
+		sbind.dataType = C.SQLT_CHR
+		sbind.pbuf = unsafe.Pointer(cStringN(value, size+1))
+		sbind.maxSize = C.sb4(size)
+		if sbind.out.In && !isNill {
+			*sbind.length = C.ub2(len(value))
+		} else {
+			*sbind.indicator = -1 // set to null
+		}
+		return nil
+	}
+
+	sbind.dataType = C.SQLT_AFC
+	sbind.pbuf = unsafe.Pointer(C.CString(value))
+	sbind.maxSize = C.sb4(len(value))
+	*sbind.length = C.ub2(len(value))
+	return nil
+}
+
+// makeBFileBind fills sbind with the locator of the BFile, or a new locator with the directory and filename of a BFile made with NewBFile
+func (stmt *Stmt) makeBFileBind(sbind *bindStruct, bfile *BFile) error {
+	if bfile.lob.locator != nil {
+		if bfile.lob.conn != stmt.conn {
+			return errors.New("bfile is from a different connection")
+		}
+		sbind.dataType = C.SQLT_FILE
+		sbind.pbuf = unsafe.Pointer(&bfile.lob.locator)
+		sbind.maxSize = C.sb4(sizeOfNilPointer)
+		*sbind.length = C.ub2(sizeOfNilPointer)
+		sbind.lob = &bfile.lob
+		return nil
+	}
+
+	fileP, _, err := stmt.conn.ociDescriptorAlloc(C.OCI_DTYPE_FILE, 0)
+	if err != nil {
+		return err
+	}
+	sbind.dataType = C.SQLT_FILE
+	sbind.pbuf = unsafe.Pointer(fileP)
+	sbind.maxSize = C.sb4(sizeOfNilPointer)
+	*sbind.length = C.ub2(sizeOfNilPointer)
+
+	directory := cString(bfile.directory)
+	defer C.free(unsafe.Pointer(directory))
+	filename := cString(bfile.filename)
+	defer C.free(unsafe.Pointer(filename))
+	result := C.OCILobFileSetName(
+		stmt.conn.env,                   // environment handle
+		stmt.conn.errHandle,             // error handle
+		(**C.OCILobLocator)(sbind.pbuf), // BFILE locator
+		directory,                       // directory alias
+		C.ub2(len(bfile.directory)),     // length of the directory alias
+		filename,                        // filename
+		C.ub2(len(bfile.filename)),      // length of the filename
+	)
+	return stmt.conn.getError(result)
+}
+
+// makeNumberBind fills sbind with a SQLT_VNU bind of the decimal number string
+func makeNumberBind(sbind *bindStruct, number string) error {
+	buf, err := encodeNumber(number)
+	if err != nil {
+		return err
+	}
+	// SQLT_VNU is the length byte followed by the NUMBER
+	sbind.dataType = C.SQLT_VNU
+	sbind.pbuf = unsafe.Pointer(cByteN(append([]byte{byte(len(buf))}, buf...), 22))
+	sbind.maxSize = 22
+	*sbind.length = 22
+	return nil
+}
+
+// makeTimeBind fills sbind with a bind of dataType SQLT_ODT, SQLT_TIMESTAMP, or SQLT_TIMESTAMP_TZ containing aTime.
+// DATE and TIMESTAMP values are in the conn time location.
+func (stmt *Stmt) makeTimeBind(sbind *bindStruct, dataType C.ub2, aTime time.Time) error {
+	sbind.dataType = dataType
+	if dataType == C.SQLT_ODT {
+		sbind.pbuf = unsafe.Pointer(stmt.conn.timeToOCIDate(aTime))
+		sbind.maxSize = C.sizeof_OCIDate
+		*sbind.length = C.sizeof_OCIDate
+		return nil
+	}
+
+	descriptorType := C.ub4(C.OCI_DTYPE_TIMESTAMP_TZ)
+	if dataType == C.SQLT_TIMESTAMP {
+		descriptorType = C.OCI_DTYPE_TIMESTAMP
+		aTime = aTime.In(stmt.conn.timeLocation)
+	}
+	dateTimePP, err := stmt.conn.timeToOCIDateTimeType(&aTime, descriptorType)
+	if err != nil {
+		return fmt.Errorf("timeToOCIDateTime error: %v", err)
+	}
+	sbind.pbuf = unsafe.Pointer(dateTimePP)
+	sbind.maxSize = C.sb4(sizeOfNilPointer)
+	*sbind.length = C.ub2(sizeOfNilPointer)
+	return nil
+}
+
+// timeDataType returns the data type of an array or DML RETURNING element of type time.Time, Date, Timestamp, or TimestampTZ.
+// time.Time elements use the time_bind data type like time.Time values.
+func (conn *Conn) timeDataType(valueType reflect.Type) C.ub2 {
+	switch valueType {
+	case typeDate:
+		return C.SQLT_ODT
+	case typeTimestamp:
+		return C.SQLT_TIMESTAMP
+	case typeTimestampTZ:
+		return C.SQLT_TIMESTAMP_TZ
+	}
+	return conn.timeBind
+}
+
+// timeValue returns the time.Time of a time.Time, Date, Timestamp, or TimestampTZ value
+func timeValue(value driver.Value) time.Time {
+	switch value := value.(type) {
+	case Date:
+		return time.Time(value)
+	case Timestamp:
+		return time.Time(value)
+	case TimestampTZ:
+		return time.Time(value)
+	}
+	return value.(time.Time)
+}
+
+// outBindSize returns the buffer size of a string or []byte out bind with an in value of length valueLen,
+// outSize is the OutSize option
+func outBindSize(outSize int, valueLen int) int {
+	size := outSize
+	if size <= 0 || size > 32767 {
+		size = 32767
+	}
+	if valueLen > size {
+		size = valueLen
+	}
+	return size
+}
+
+// isOutBindDest returns true if dest is a supported out bind destination.
+// Object, collection, array, and ref cursor destinations are handled before.
+func isOutBindDest(dest interface{}) bool {
+	switch dest.(type) {
+	case *string, *sql.NullString, *Clob, *[]byte, *Blob,
+		*int, *int64, *int32, *int16, *int8, *sql.NullInt64,
+		*uint, *uint64, *uint32, *uint16, *uint8, *uintptr,
+		*float64, *float32, *sql.NullFloat64, *bool, *sql.NullBool,
+		*Lob, *NString, *time.Time, *sql.NullTime, *Date, *Timestamp, *TimestampTZ, *time.Duration, *IntervalDS, *IntervalYM, *Number, *big.Int:
+		return true
+	}
+	return false
+}
+
+// nativeBool returns true if bool values are bound as SQLT_BOL:
+// the native_bool DSN parameter is set and the client and server are 12.1 or later for PL/SQL, 23 or later for SQL
+func (stmt *Stmt) nativeBool() (bool, error) {
+	if !stmt.conn.nativeBool || stmt.conn.clientVersion < 12 || stmt.conn.serverVersion < 12 {
+		return false, nil
+	}
+	if stmt.conn.clientVersion >= 23 && stmt.conn.serverVersion >= 23 {
+		return true, nil
+	}
+	return stmt.isPlsql()
+}
+
+// isPlsql returns true if the statement is a PL/SQL block
+func (stmt *Stmt) isPlsql() (bool, error) {
+	var stmtType C.ub2
+	_, err := stmt.ociAttrGet(unsafe.Pointer(&stmtType), C.OCI_ATTR_STMT_TYPE)
+	if err != nil {
+		return false, err
+	}
+	return stmtType == C.OCI_STMT_BEGIN || stmtType == C.OCI_STMT_DECLARE, nil
+}
+
+// makeCursorBind fills sbind with a new statement handle for a REF CURSOR if the value is a sql.Out with a *driver.Rows as Dest.
+// Returns false if the value is not bound as a REF CURSOR.
+func (stmt *Stmt) makeCursorBind(sbind *bindStruct, value interface{}) (bool, error) {
+	out, ok := value.(sql.Out)
+	if !ok {
+		return false, nil
+	}
+	if _, ok = out.Dest.(*driver.Rows); !ok {
+		return false, nil
+	}
+	if out.In {
+		return false, errors.New("ref cursor cannot be an in bind")
+	}
+
+	stmtP, _, err := stmt.conn.ociHandleAlloc(C.OCI_HTYPE_STMT, 0)
+	if err != nil {
+		return false, err
+	}
+
+	sbind.out = out
+	sbind.dataType = C.SQLT_RSET
+	sbind.maxSize = 0
+	sbind.pbuf = unsafe.Pointer(stmtP)
+
+	return true, nil
+}
+
+// cursorRows returns the rows of the REF CURSOR statement handle from an out bind.
+// The rows own the statement handle and free it on close.
+func (stmt *Stmt) cursorRows(cursorStmt *C.OCIStmt) (*Rows, error) {
+	subStmt := &Stmt{conn: stmt.conn, stmt: cursorStmt, ctx: stmt.ctx, releaseMode: C.ub4(C.OCI_DEFAULT), exactNumber: stmt.exactNumber,
+		lobLocator: stmt.lobLocator, lobPrefetch: stmt.lobPrefetch}
+
+	defines, err := subStmt.makeDefines(stmt.conn.fetchArraySize)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := &Rows{
+		stmt:       subStmt,
+		defines:    defines,
+		freeHandle: true,
+	}
+	if len(defines) > 0 {
+		rows.arraySize = defines[0].arraySize
+	}
 
-				if len(value) > 32767 {
-					var lobP *unsafe.Pointer
-					lobP, _, err = stmt.conn.ociDescriptorAlloc(C.OCI_DTYPE_LOB, 0)
-					if err != nil {
-						freeBinds(binds)
-						return nil, err
-					}
-					sbind.dataType = C.SQLT_BLOB
-					sbind.pbuf = unsafe.Pointer(lobP)
-					sbind.maxSize = C.sb4(sizeOfNilPointer)
-					*sbind.length = C.ub2(sizeOfNilPointer)
-					lobLocator := (**C.OCILobLocator)(sbind.pbuf)
-					err = stmt.conn.ociLobCreateTemporary(*lobLocator, C.SQLCS_IMPLICIT, C.OCI_TEMP_BLOB)
-					if err != nil {
-						freeBinds(binds)
-						return nil, err
-					}
-					err = stmt.conn.ociLobWrite(*lobLocator, C.SQLCS_IMPLICIT, value)
-					if err != nil {
-						freeBinds(binds)
-						return nil, err
-					}
-				} else {
-					sbind.dataType = C.SQLT_BIN
-					sbind.pbuf = unsafe.Pointer(cByteN(value, 32768))
-					sbind.maxSize = 32767
-					if sbind.out.In && !isNill {
-						*sbind.length = C.ub2(len(value))
-					} else {
-						*sbind.indicator = -1 // set to null
-					}
-				}
+	return rows, nil
+}
 
-			} else {
+// makePlsqlArrayBind fills sbind with a PL/SQL associative array (index-by table) if the statement is PL/SQL
+// and the value is a slice or a sql.Out with a pointer to a slice as Dest.
+// Returns false if the value is not bound as a PL/SQL array.
+// An out slice can return up to its capacity number of elements and is resized to the returned number of elements.
+func (stmt *Stmt) makePlsqlArrayBind(sbind *bindStruct, value interface{}) (bool, error) {
+	var slice reflect.Value
+	var maxLen int
+	minSize := 1
+
+	out, isOut := value.(sql.Out)
+	if isOut {
+		destValue := reflect.ValueOf(out.Dest)
+		if destValue.Kind() != reflect.Ptr || destValue.IsNil() || !isArrayBindType(destValue.Type().Elem()) {
+			return false, nil
+		}
+		slice = destValue.Elem()
+		maxLen = slice.Cap()
+		if !out.In {
+			slice = slice.Slice(0, 0)
+		}
+		minSize = 4000
+	} else {
+		if !isArrayBindType(reflect.TypeOf(value)) {
+			return false, nil
+		}
+		slice = reflect.ValueOf(value)
+		maxLen = slice.Len()
+	}
 
-				if len(value) > 32767 {
-					var lobP *unsafe.Pointer
-					lobP, _, err = stmt.conn.ociDescriptorAlloc(C.OCI_DTYPE_LOB, 0)
-					if err != nil {
-						freeBinds(binds)
-						return nil, err
-					}
-					sbind.dataType = C.SQLT_BLOB
-					sbind.pbuf = unsafe.Pointer(lobP)
-					sbind.maxSize = C.sb4(sizeOfNilPointer)
-					*sbind.length = C.ub2(sizeOfNilPointer)
-					lobLocator := (**C.OCILobLocator)(sbind.pbuf)
-					err = stmt.conn.ociLobCreateTemporary(*lobLocator, C.SQLCS_IMPLICIT, C.OCI_TEMP_BLOB)
-					if err != nil {
-						freeBinds(binds)
-						return nil, err
-					}
-					err = stmt.conn.ociLobWrite(*lobLocator, C.SQLCS_IMPLICIT, value)
-					if err != nil {
-						freeBinds(binds)
-						return nil, err
-					}
-				} else {
-					sbind.dataType = C.SQLT_BIN
-					sbind.pbuf = unsafe.Pointer(cByte(value))
-					sbind.maxSize = C.sb4(len(value))
-					*sbind.length = C.ub2(len(value))
-				}
+	isPlsql, err := stmt.isPlsql()
+	if err != nil {
+		return false, err
+	}
+	if !isPlsql {
+		if isOut {
+			return false, errors.New("out slices are only supported by PL/SQL and DML RETURNING")
+		}
+		return false, nil
+	}
 
-			}
+	if maxLen < 1 {
+		if isOut {
+			return false, errors.New("out slice capacity must be greater than 0")
+		}
+		maxLen = 1
+	}
 
-		case time.Time:
-			sbind.dataType = C.SQLT_TIMESTAMP_TZ
-			sbind.maxSize = C.sb4(sizeOfNilPointer)
-			*sbind.length = C.ub2(sizeOfNilPointer)
+	sbind.out = out
+	err = stmt.makeArrayBind(sbind, slice, maxLen, minSize)
+	if err != nil {
+		return false, err
+	}
+	sbind.maxArrayLen = C.ub4(maxLen)
+	sbind.curArrayLen = (*C.ub4)(C.malloc(C.sizeof_ub4))
+	*sbind.curArrayLen = C.ub4(slice.Len())
 
-			dateTimePP, err := stmt.conn.timeToOCIDateTime(&value)
-			if err != nil {
-				freeBinds(binds)
-				return nil, fmt.Errorf("timeToOCIDateTime for column %v - error: %v", i, err)
-			}
+	return true, nil
+}
 
-			sbind.pbuf = unsafe.Pointer(dateTimePP)
+// arrayElementType returns the driver value type of the slice element type, or nil if it is not known without a value
+func arrayElementType(elemType reflect.Type) reflect.Type {
+	for elemType.Kind() == reflect.Ptr {
+		elemType = elemType.Elem()
+	}
+	if elemType == typeBigInt {
+		return typeNumber
+	}
+	value, err := arrayElementValue(reflect.Zero(elemType).Interface())
+	if err != nil || value == nil {
+		return nil
+	}
+	return reflect.TypeOf(value)
+}
 
-		case string:
-			if isOut {
+// arrayElementValue converts a slice element to a driver value.
+// Unsigned integers and big.Int are converted to Number like scalar binds, so values greater than max int64 do not overflow,
+// and Date, Timestamp, and TimestampTZ are kept so they are bound as their data type.
+// Other elements are converted with driver.DefaultParameterConverter.
+func arrayElementValue(element interface{}) (driver.Value, error) {
+	switch value := element.(type) {
+	case Number:
+		return value, nil
+	case uint, uint64, uintptr:
+		return Number(fmt.Sprint(value)), nil
+	case *big.Int:
+		if value == nil {
+			return nil, nil
+		}
+		return Number(value.String()), nil
+	case Date, Timestamp, TimestampTZ:
+		// not converted to time.Time by their Value, so they keep their data type
+		return value, nil
+	case nullValue:
+		return arrayElementValue(value.bindValue())
+	case *uint, *uint64, *uintptr, *Number, *Date, *Timestamp, *TimestampTZ:
+		elemValue := reflect.ValueOf(value)
+		if elemValue.IsNil() {
+			return nil, nil
+		}
+		return arrayElementValue(elemValue.Elem().Interface())
+	}
+	return driver.DefaultParameterConverter.ConvertValue(element)
+}
 
-				if len(value) > 32767 {
-					var lobP *unsafe.Pointer
-					lobP, _, err = stmt.conn.ociDescriptorAlloc(C.OCI_DTYPE_LOB, 0)
-					if err != nil {
-						freeBinds(binds)
-						return nil, err
-					}
-					sbind.dataType = C.SQLT_CLOB
-					sbind.pbuf = unsafe.Pointer(lobP)
-					sbind.maxSize = C.sb4(sizeOfNilPointer)
-					*sbind.length = C.ub2(sizeOfNilPointer)
-					lobLocator := (**C.OCILobLocator)(sbind.pbuf)
-					err = stmt.conn.ociLobCreateTemporary(*lobLocator, C.SQLCS_IMPLICIT, C.OCI_TEMP_CLOB)
-					if err != nil {
-						freeBinds(binds)
-						return nil, err
-					}
-					err = stmt.conn.ociLobWrite(*lobLocator, C.SQLCS_IMPLICIT, []byte(value))
-					if err != nil {
-						freeBinds(binds)
-						return nil, err
-					}
-				} else {
-					sbind.dataType = C.SQLT_CHR
-					sbind.pbuf = unsafe.Pointer(cStringN(value, 32768))
-					sbind.maxSize = 32767
-					if sbind.out.In && !isNill {
-						*sbind.length = C.ub2(len(value))
-					} else {
-						*sbind.indicator = -1 // set to null
-					}
-				}
+// makeArrayBind fills sbind with a C array of maxLen elements of the slice values.
+// Each element is converted with arrayElementValue, so pointers and driver.Valuer types like sql.NullString are supported.
+// All elements that are not nil must convert to the same type.
+// Elements after the slice values are null, and string and []byte elements are at least minSize bytes.
+func (stmt *Stmt) makeArrayBind(sbind *bindStruct, slice reflect.Value, maxLen int, minSize int) error {
+	size := slice.Len()
+	values := make([]driver.Value, size)
+	var valueType reflect.Type
+	var err error
+	for i := 0; i < size; i++ {
+		values[i], err = arrayElementValue(slice.Index(i).Interface())
+		if err != nil {
+			return fmt.Errorf("element %v - error: %v", i, err)
+		}
+		if values[i] == nil {
+			continue
+		}
+		if valueType == nil {
+			valueType = reflect.TypeOf(values[i])
+		} else if valueType != reflect.TypeOf(values[i]) {
+			return fmt.Errorf("element %v is type %T, expected type %v", i, values[i], valueType)
+		}
+	}
+	if valueType == nil {
+		valueType = arrayElementType(slice.Type().Elem())
+	}
 
-			} else {
+	C.free(unsafe.Pointer(sbind.length))
+	C.free(unsafe.Pointer(sbind.indicator))
+	sbind.isArray = true
+	sbind.arrayLen = maxLen
+	sbind.dataType = C.SQLT_AFC
+	sbind.length = (*C.ub2)(C.malloc(C.size_t(maxLen+1) * C.sizeof_ub2))
+	sbind.indicator = (*C.sb2)(C.malloc(C.size_t(maxLen+1) * C.sizeof_sb2))
+	lengths := (*[1 << 27]C.ub2)(unsafe.Pointer(sbind.length))[:maxLen:maxLen]
+	indicators := (*[1 << 27]C.sb2)(unsafe.Pointer(sbind.indicator))[:maxLen:maxLen]
+	for i := 0; i < maxLen; i++ {
+		lengths[i] = 0
+		if i >= size || values[i] == nil {
+			indicators[i] = -1 // set to null
+		} else {
+			indicators[i] = 0
+		}
+	}
 
-				if len(value) > 32767 {
-					var lobP *unsafe.Pointer
-					lobP, _, err = stmt.conn.ociDescriptorAlloc(C.OCI_DTYPE_LOB, 0)
-					if err != nil {
-						freeBinds(binds)
-						return nil, err
-					}
-					sbind.dataType = C.SQLT_CLOB
-					sbind.pbuf = unsafe.Pointer(lobP)
-					sbind.maxSize = C.sb4(sizeOfNilPointer)
-					*sbind.length = C.ub2(sizeOfNilPointer)
-					lobLocator := (**C.OCILobLocator)(sbind.pbuf)
-					err = stmt.conn.ociLobCreateTemporary(*lobLocator, C.SQLCS_IMPLICIT, C.OCI_TEMP_CLOB)
-					if err != nil {
-						freeBinds(binds)
-						return nil, err
-					}
-					err = stmt.conn.ociLobWrite(*lobLocator, C.SQLCS_IMPLICIT, []byte(value))
-					if err != nil {
-						freeBinds(binds)
-						return nil, err
-					}
-				} else {
-					sbind.dataType = C.SQLT_AFC
-					sbind.pbuf = unsafe.Pointer(C.CString(value))
-					sbind.maxSize = C.sb4(len(value))
-					*sbind.length = C.ub2(len(value))
-				}
+	switch valueType {
+
+	case nil: // all nulls
+		sbind.maxSize = C.sb4(minSize)
+		sbind.pbuf = C.malloc(C.size_t(maxLen+1) * C.size_t(minSize))
+
+	case typeInt64:
+		sbind.dataType = C.SQLT_INT
+		sbind.maxSize = 8
+		sbind.pbuf = C.malloc(C.size_t(maxLen+1) * 8)
+		buffer := (*[1 << 27]int64)(sbind.pbuf)[:maxLen:maxLen]
+		for i := 0; i < maxLen; i++ {
+			lengths[i] = 8
+			if i < size && values[i] != nil {
+				buffer[i] = values[i].(int64)
+			}
+		}
 
+	case typeFloat64:
+		sbind.dataType = C.SQLT_BDOUBLE
+		sbind.maxSize = 8
+		sbind.pbuf = C.malloc(C.size_t(maxLen+1) * 8)
+		buffer := (*[1 << 27]float64)(sbind.pbuf)[:maxLen:maxLen]
+		for i := 0; i < maxLen; i++ {
+			lengths[i] = 8
+			if i < size && values[i] != nil {
+				buffer[i] = values[i].(float64)
 			}
+		}
 
-		case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, uintptr:
-			buffer := bytes.Buffer{}
-			err = binary.Write(&buffer, binary.LittleEndian, value)
-			if err != nil {
-				freeBinds(binds)
-				return nil, fmt.Errorf("binary read for column %v - error: %v", i, err)
+	case typeBool:
+		var nativeBool bool
+		nativeBool, err = stmt.nativeBool()
+		if err != nil {
+			return err
+		}
+		if nativeBool {
+			sbind.dataType = C.SQLT_BOL
+			sbind.maxSize = C.sizeof_int
+			sbind.pbuf = C.malloc(C.size_t(maxLen+1) * C.sizeof_int)
+			buffer := (*[1 << 27]C.int)(sbind.pbuf)[:maxLen:maxLen]
+			for i := 0; i < maxLen; i++ {
+				lengths[i] = C.sizeof_int
+				buffer[i] = 0
+				if i < size && values[i] != nil && values[i].(bool) {
+					buffer[i] = 1
+				}
 			}
-			sbind.dataType = C.SQLT_INT
-			sbind.pbuf = unsafe.Pointer(cByte(buffer.Bytes()))
-			sbind.maxSize = C.sb4(buffer.Len())
-			*sbind.length = C.ub2(buffer.Len())
-			if isOut && sbind.out.In && isNill {
-				*sbind.indicator = -1 // set to null
+			break
+		}
+
+		// handle as 0/1 int
+		sbind.dataType = C.SQLT_INT
+		sbind.maxSize = 1
+		sbind.pbuf = C.malloc(C.size_t(maxLen + 1))
+		buffer := (*[1 << 30]byte)(sbind.pbuf)[:maxLen:maxLen]
+		for i := 0; i < maxLen; i++ {
+			lengths[i] = 1
+			buffer[i] = 0
+			if i < size && values[i] != nil && values[i].(bool) {
+				buffer[i] = 1
 			}
+		}
 
-		case float32, float64:
-			buffer := bytes.Buffer{}
-			err = binary.Write(&buffer, binary.LittleEndian, value)
-			if err != nil {
-				freeBinds(binds)
-				return nil, fmt.Errorf("binary read for column %v - error: %v", i, err)
+	case typeNumber: // SQLT_VNU is the length byte followed by the NUMBER
+		sbind.dataType = C.SQLT_VNU
+		sbind.maxSize = 22
+		sbind.pbuf = C.malloc(C.size_t(maxLen+1) * 22)
+		buffer := (*[1 << 30]byte)(sbind.pbuf)[: maxLen*22 : maxLen*22]
+		for i := 0; i < maxLen; i++ {
+			lengths[i] = 22
+			buffer[i*22] = 0
+			if i >= size || values[i] == nil {
+				continue
 			}
-			sbind.dataType = C.SQLT_BDOUBLE
-			sbind.pbuf = unsafe.Pointer(cByte(buffer.Bytes()))
-			sbind.maxSize = C.sb4(buffer.Len())
-			*sbind.length = C.ub2(buffer.Len())
-			if isOut && sbind.out.In && isNill {
-				*sbind.indicator = -1 // set to null
+			var number []byte
+			number, err = encodeNumber(string(values[i].(Number)))
+			if err != nil {
+				return fmt.Errorf("number for element %v - error: %v", i, err)
 			}
+			buffer[i*22] = byte(len(number))
+			copy(buffer[i*22+1:(i+1)*22], number)
+		}
 
-		case bool: // oracle does not have bool, handle as 0/1 int
-			sbind.dataType = C.SQLT_INT
-			if value {
-				sbind.pbuf = unsafe.Pointer(cByte([]byte{1}))
+	case typeString, typeSliceByte:
+		sbind.dataType = C.SQLT_AFC
+		if valueType == typeSliceByte {
+			sbind.dataType = C.SQLT_BIN
+		}
+		maxSize := minSize
+		for i := 0; i < size; i++ {
+			if values[i] == nil {
+				continue
+			}
+			var length int
+			if valueType == typeString {
+				length = len(values[i].(string))
 			} else {
-				sbind.pbuf = unsafe.Pointer(cByte([]byte{0}))
+				length = len(values[i].([]byte))
 			}
-			sbind.maxSize = 1
-			*sbind.length = 1
-			if isOut && sbind.out.In && isNill {
-				*sbind.indicator = -1 // set to null
+			if length > 32767 {
+				return fmt.Errorf("element %v length %v is greater than max array element length of 32767", i, length)
 			}
-
-		default:
-			if isOut {
-				// TODO: should this error instead of setting to null?
-				sbind.dataType = C.SQLT_AFC
-				sbind.pbuf = nil
-				sbind.maxSize = 0
-				*sbind.length = 0
-				*sbind.indicator = -1 // set to null
+			if length > maxSize {
+				maxSize = length
+			}
+		}
+		sbind.maxSize = C.sb4(maxSize)
+		sbind.pbuf = C.malloc(C.size_t(maxLen+1) * C.size_t(maxSize))
+		buffer := (*[1 << 30]byte)(sbind.pbuf)[: maxLen*maxSize : maxLen*maxSize]
+		for i := 0; i < size; i++ {
+			if values[i] == nil {
+				continue
+			}
+			var length int
+			if valueType == typeString {
+				length = copy(buffer[i*maxSize:(i+1)*maxSize], values[i].(string))
 			} else {
-				d := fmt.Sprintf("%v", value)
-				sbind.dataType = C.SQLT_AFC
-				sbind.pbuf = unsafe.Pointer(C.CString(d))
-				sbind.maxSize = C.sb4(len(d))
-				*sbind.length = C.ub2(len(d))
+				length = copy(buffer[i*maxSize:(i+1)*maxSize], values[i].([]byte))
 			}
+			lengths[i] = C.ub2(length)
 		}
 
-		// add to binds now so if error will be freed by freeBinds call
-		binds = append(binds, sbind)
+	case typeTime, typeDate, typeTimestamp, typeTimestampTZ:
+		sbind.dataType = stmt.conn.timeDataType(valueType)
+		if sbind.dataType == C.SQLT_ODT {
+			sbind.maxSize = C.sizeof_OCIDate
+			sbind.pbuf = C.malloc(C.size_t(maxLen+1) * C.sizeof_OCIDate)
+			buffer := (*[1 << 25]C.OCIDate)(sbind.pbuf)[:maxLen:maxLen]
+			for i := 0; i < maxLen; i++ {
+				lengths[i] = C.sizeof_OCIDate
+				buffer[i] = C.OCIDate{}
+				if i < size && values[i] != nil {
+					stmt.conn.setOCIDate(&buffer[i], timeValue(values[i]))
+				}
+			}
+			break
+		}
 
-		if useValues || len(namedValues[i].Name) < 1 {
-			err = stmt.ociBindByPos(C.ub4(i+1), &sbind)
-			// TODO: should we use namedValues[i]Ordinal?
-		} else {
-			err = stmt.ociBindByName([]byte(":"+namedValues[i].Name), &sbind)
+		descriptorType := C.ub4(C.OCI_DTYPE_TIMESTAMP_TZ)
+		if sbind.dataType == C.SQLT_TIMESTAMP {
+			descriptorType = C.OCI_DTYPE_TIMESTAMP
 		}
-		if err != nil {
-			freeBinds(binds)
-			return nil, err
+		sbind.maxSize = C.sb4(sizeOfNilPointer)
+		sbind.pbuf = C.malloc(C.size_t(maxLen+1) * C.size_t(sizeOfNilPointer))
+		buffer := (*[1 << 27]unsafe.Pointer)(sbind.pbuf)[:maxLen:maxLen]
+		for i := 0; i < maxLen; i++ {
+			buffer[i] = nil
+		}
+		for i := 0; i < maxLen; i++ {
+			lengths[i] = C.ub2(sizeOfNilPointer)
+			var dateTimePP *unsafe.Pointer
+			if i >= size || values[i] == nil {
+				dateTimePP, _, err = stmt.conn.ociDescriptorAlloc(descriptorType, 0)
+				if err != nil {
+					return fmt.Errorf("element %v - error: %v", i, err)
+				}
+			} else {
+				aTime := timeValue(values[i])
+				if sbind.dataType == C.SQLT_TIMESTAMP {
+					aTime = aTime.In(stmt.conn.timeLocation)
+				}
+				dateTimePP, err = stmt.conn.timeToOCIDateTimeType(&aTime, descriptorType)
+				if err != nil {
+					return fmt.Errorf("timeToOCIDateTime for element %v - error: %v", i, err)
+				}
+			}
+			buffer[i] = *dateTimePP
 		}
 
+	default:
+		return fmt.Errorf("unsupported array element type %v", valueType)
 	}
 
-	return binds, nil
+	return nil
+}
+
+// bindsIters returns the number of times the statement needs to be executed for the binds.
+// Returns 1 if there are no array binds.
+func bindsIters(binds []bindStruct) (C.ub4, error) {
+	iters := -1
+	var hasScalar bool
+	for _, bind := range binds {
+		if bind.returning != nil {
+			// DML RETURNING binds return the values of all iterations
+			continue
+		}
+		if !bind.isArray || bind.curArrayLen != nil {
+			// PL/SQL arrays are bound once like non array binds
+			hasScalar = true
+			continue
+		}
+		if iters == -1 {
+			iters = bind.arrayLen
+		} else if iters != bind.arrayLen {
+			return 0, fmt.Errorf("array binds have different lengths: %v and %v", iters, bind.arrayLen)
+		}
+	}
+	if iters == -1 {
+		return 1, nil
+	}
+	if hasScalar {
+		return 0, errors.New("cannot mix array binds with non array binds")
+	}
+	return C.ub4(iters), nil
 }
 
 // Query runs a query
 func (stmt *Stmt) Query(values []driver.Value) (driver.Rows, error) {
 	stmt.ctx = context.Background()
-	binds, err := stmt.bindValues(values, nil)
+	options := stmt.takeOptions()
+	binds, err := stmt.bindValues(values, nil, &options)
 	if err != nil {
 		return nil, err
 	}
 
-	return stmt.query(binds)
+	return stmt.query(binds, options)
 }
 
 // QueryContext runs a query with context
 func (stmt *Stmt) QueryContext(ctx context.Context, namedValues []driver.NamedValue) (driver.Rows, error) {
 	stmt.ctx = ctx
-	binds, err := stmt.bindValues(nil, namedValues)
+	options := stmt.takeOptions()
+	binds, err := stmt.bindValues(nil, namedValues, &options)
 	if err != nil {
 		return nil, err
 	}
 
-	return stmt.query(binds)
+	return stmt.query(binds, options)
 }
 
 // query runs a query with context
-func (stmt *Stmt) query(binds []bindStruct) (driver.Rows, error) {
+func (stmt *Stmt) query(binds []bindStruct, options stmtOptions) (driver.Rows, error) {
 	defer freeBinds(binds)
 
+	for _, bind := range binds {
+		if bind.isArray && bind.curArrayLen == nil {
+			return nil, errors.New("array binds are only supported by exec")
+		}
+	}
+
 	var stmtType C.ub2
 	_, err := stmt.ociAttrGet(unsafe.Pointer(&stmtType), C.OCI_ATTR_STMT_TYPE)
 	if err != nil {
@@ -423,6 +1483,11 @@ func (stmt *Stmt) query(binds []bindStruct) (driver.Rows, error) {
 		mode = mode | C.OCI_COMMIT_ON_SUCCESS
 	}
 
+	err = stmt.writeLobReaders(binds)
+	if err != nil {
+		return nil, err
+	}
+
 	if stmt.ctx.Err() != nil {
 		return nil, stmt.ctx.Err()
 	}
@@ -435,8 +1500,38 @@ func (stmt *Stmt) query(binds []bindStruct) (driver.Rows, error) {
 		return nil, err
 	}
 
+	arraySize := stmt.conn.fetchArraySize
+	if options.fetchArraySize > 0 {
+		arraySize = options.fetchArraySize
+	}
+	if arraySize < 1 {
+		arraySize = 1
+	}
+	stmt.exactNumber = options.exactNumber
+	stmt.lobLocator = options.lobLocator
+	stmt.lobPrefetch = options.lobPrefetch
+
+	if stmtType == C.OCI_STMT_BEGIN || stmtType == C.OCI_STMT_DECLARE {
+		var implicitResultCount C.ub4
+		// OCI_ATTR_IMPLICIT_RESULT_COUNT needs Oracle 12.1 or later, so an error is treated as no implicit results
+		_, err = stmt.ociAttrGet(unsafe.Pointer(&implicitResultCount), C.OCI_ATTR_IMPLICIT_RESULT_COUNT)
+		if err == nil && implicitResultCount > 0 {
+			rows := &Rows{
+				implicitStmt:        stmt,
+				implicitResultCount: int(implicitResultCount),
+				implicitArraySize:   arraySize,
+			}
+			err = rows.nextImplicitResult()
+			if err != nil {
+				return nil, err
+			}
+			rows.keepTemporaryLobs(binds)
+			return rows, nil
+		}
+	}
+
 	var defines []defineStruct
-	defines, err = stmt.makeDefines()
+	defines, err = stmt.makeDefines(arraySize)
 	if err != nil {
 		return nil, err
 	}
@@ -450,11 +1545,16 @@ func (stmt *Stmt) query(binds []bindStruct) (driver.Rows, error) {
 		stmt:    stmt,
 		defines: defines,
 	}
+	rows.keepTemporaryLobs(binds)
+	if len(defines) > 0 {
+		rows.arraySize = defines[0].arraySize
+	}
 
 	return rows, nil
 }
 
-func (stmt *Stmt) makeDefines() ([]defineStruct, error) {
+// makeDefines makes the defines for the select-list with buffers for arraySize rows
+func (stmt *Stmt) makeDefines(arraySize int) ([]defineStruct, error) {
 	var paramCountUb4 C.ub4 // number of columns in the select-list
 	_, err := stmt.ociAttrGet(unsafe.Pointer(&paramCountUb4), C.OCI_ATTR_PARAM_COUNT)
 	if err != nil {
@@ -462,6 +1562,25 @@ func (stmt *Stmt) makeDefines() ([]defineStruct, error) {
 	}
 	paramCount := int(paramCountUb4)
 
+	if arraySize > 1 {
+		// ref cursors are fetched one row at a time because the sub defines are made for each cursor statement handle
+		var hasCursor bool
+		hasCursor, err = stmt.hasColumnDataType(paramCount, C.SQLT_RSET)
+		if err != nil {
+			return nil, err
+		}
+		if !hasCursor {
+			// object instances are fetched one row at a time because OCIDefineObject takes a single instance pointer
+			hasCursor, err = stmt.hasColumnDataType(paramCount, C.SQLT_NTY)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if hasCursor {
+			arraySize = 1
+		}
+	}
+
 	defines := make([]defineStruct, paramCount)
 
 	for i := 0; i < paramCount; i++ {
@@ -501,10 +1620,26 @@ func (stmt *Stmt) makeDefines() ([]defineStruct, error) {
 			return nil, err
 		}
 
-		defines[i].length = (*C.ub2)(C.malloc(C.sizeof_ub2))
-		*defines[i].length = 0
-		defines[i].indicator = (*C.sb2)(C.malloc(C.sizeof_sb2))
-		*defines[i].indicator = 0
+		var charsetForm C.ub1 // character set form of the column, SQLCS_NCHAR for NCHAR, NVARCHAR2, and NCLOB columns
+		_, err = stmt.conn.ociAttrGet(param, unsafe.Pointer(&charsetForm), C.OCI_ATTR_CHARSET_FORM)
+		if err != nil {
+			freeDefines(defines)
+			return nil, err
+		}
+		defines[i].charsetForm = C.SQLCS_IMPLICIT
+		if charsetForm == C.SQLCS_NCHAR {
+			defines[i].charsetForm = C.SQLCS_NCHAR
+		}
+
+		defines[i].arraySize = arraySize
+		defines[i].length = (*C.ub2)(C.malloc(C.size_t(arraySize) * C.sizeof_ub2))
+		defines[i].indicator = (*C.sb2)(C.malloc(C.size_t(arraySize) * C.sizeof_sb2))
+		lengths := (*[1 << 27]C.ub2)(unsafe.Pointer(defines[i].length))[:arraySize:arraySize]
+		indicators := (*[1 << 27]C.sb2)(unsafe.Pointer(defines[i].indicator))[:arraySize:arraySize]
+		for j := 0; j < arraySize; j++ {
+			lengths[j] = 0
+			indicators[j] = 0
+		}
 
 		// switch on dataType
 		switch dataType {
@@ -514,12 +1649,12 @@ func (stmt *Stmt) makeDefines() ([]defineStruct, error) {
 			// For a database with character set to ZHS16GBK the OCI C driver does not seem to report the correct max size, not sure exactly why.
 			// Doubling the max size of the buffer seems to fix the issue, not sure if there is a better fix.
 			defines[i].maxSize = C.sb4(maxSize * 2)
-			defines[i].pbuf = C.malloc(C.size_t(defines[i].maxSize))
+			defines[i].pbuf = C.malloc(C.size_t(defines[i].maxSize) * C.size_t(arraySize))
 
 		case C.SQLT_BIN:
 			defines[i].dataType = C.SQLT_BIN
 			defines[i].maxSize = C.sb4(maxSize)
-			defines[i].pbuf = C.malloc(C.size_t(defines[i].maxSize))
+			defines[i].pbuf = C.malloc(C.size_t(defines[i].maxSize) * C.size_t(arraySize))
 
 		case C.SQLT_NUM:
 			var precision C.sb2 // the precision
@@ -543,90 +1678,114 @@ func (stmt *Stmt) makeDefines() ([]defineStruct, error) {
 
 			// note that select sum and count both return as precision == 0 && scale == 0 so use float64 (SQLT_BDOUBLE) to handle both
 
-			if (precision == 0 && scale == 0) || scale > 0 || scale == -127 {
+			if (stmt.conn.exactNumber || stmt.exactNumber) && (scale != 0 || precision == 0 || precision > 18) {
+				// exact numbers, integers of up to 18 digits always fit in an int64
+				defines[i].dataType = C.SQLT_VNU
+				defines[i].maxSize = 22
+				defines[i].pbuf = C.malloc(C.size_t(defines[i].maxSize) * C.size_t(arraySize))
+			} else if (precision == 0 && scale == 0) || scale > 0 || scale == -127 {
 				defines[i].dataType = C.SQLT_BDOUBLE
 				defines[i].maxSize = 8
-				defines[i].pbuf = C.malloc(C.size_t(defines[i].maxSize))
+				defines[i].pbuf = C.malloc(C.size_t(defines[i].maxSize) * C.size_t(arraySize))
+			} else if precision > 18 {
+				// integers that may not fit in an int64
+				defines[i].dataType = C.SQLT_VNU
+				defines[i].maxSize = 22
+				defines[i].pbuf = C.malloc(C.size_t(defines[i].maxSize) * C.size_t(arraySize))
+				defines[i].bigInteger = true
 			} else {
 				defines[i].dataType = C.SQLT_INT
 				defines[i].maxSize = 8
-				defines[i].pbuf = C.malloc(C.size_t(defines[i].maxSize))
+				defines[i].pbuf = C.malloc(C.size_t(defines[i].maxSize) * C.size_t(arraySize))
 			}
 
 		case C.SQLT_INT:
 			defines[i].dataType = C.SQLT_INT
 			defines[i].maxSize = 8
-			defines[i].pbuf = C.malloc(C.size_t(defines[i].maxSize))
+			defines[i].pbuf = C.malloc(C.size_t(defines[i].maxSize) * C.size_t(arraySize))
 
 		case C.SQLT_BDOUBLE, C.SQLT_IBDOUBLE, C.SQLT_BFLOAT, C.SQLT_IBFLOAT:
 			defines[i].dataType = C.SQLT_BDOUBLE
 			defines[i].maxSize = 8
-			defines[i].pbuf = C.malloc(C.size_t(defines[i].maxSize))
+			defines[i].pbuf = C.malloc(C.size_t(defines[i].maxSize) * C.size_t(arraySize))
+
+		case C.SQLT_BOL: // BOOLEAN column, only described by 23 and later clients and servers
+			defines[i].dataType = C.SQLT_BOL
+			defines[i].maxSize = C.sizeof_int
+			defines[i].pbuf = C.malloc(C.size_t(defines[i].maxSize) * C.size_t(arraySize))
 
 		case C.SQLT_LNG:
 			defines[i].dataType = C.SQLT_LNG
 			defines[i].maxSize = 4000
-			defines[i].pbuf = C.malloc(C.size_t(defines[i].maxSize))
+			defines[i].pbuf = C.malloc(C.size_t(defines[i].maxSize) * C.size_t(arraySize))
+
+		case C.SQLT_CLOB, C.SQLT_BLOB:
+			defines[i].dataType = dataType
+			defines[i].maxSize = C.sb4(sizeOfNilPointer)
+			defines[i].pbuf, err = stmt.conn.ociDescriptorArrayAlloc(C.OCI_DTYPE_LOB, defines[i].dataType, arraySize)
+			if err != nil {
+				freeDefines(defines)
+				return nil, err
+			}
 
-		case C.SQLT_CLOB, C.SQLT_BLOB:
-			defines[i].dataType = dataType
+		case C.SQLT_FILE:
+			defines[i].dataType = C.SQLT_FILE
 			defines[i].maxSize = C.sb4(sizeOfNilPointer)
-			var lobP *unsafe.Pointer
-			lobP, _, err = stmt.conn.ociDescriptorAlloc(C.OCI_DTYPE_LOB, 0)
+			defines[i].pbuf, err = stmt.conn.ociDescriptorArrayAlloc(C.OCI_DTYPE_FILE, defines[i].dataType, arraySize)
 			if err != nil {
 				freeDefines(defines)
 				return nil, err
 			}
-			defines[i].pbuf = unsafe.Pointer(lobP)
 
 		case C.SQLT_TIMESTAMP, C.SQLT_DAT:
 			defines[i].dataType = C.SQLT_TIMESTAMP
 			defines[i].maxSize = C.sb4(sizeOfNilPointer)
-			var timestampP *unsafe.Pointer
-			timestampP, _, err = stmt.conn.ociDescriptorAlloc(C.OCI_DTYPE_TIMESTAMP, 0)
+			defines[i].pbuf, err = stmt.conn.ociDescriptorArrayAlloc(C.OCI_DTYPE_TIMESTAMP, defines[i].dataType, arraySize)
 			if err != nil {
 				freeDefines(defines)
 				return nil, err
 			}
-			defines[i].pbuf = unsafe.Pointer(timestampP)
 
-		case C.SQLT_TIMESTAMP_TZ, C.SQLT_TIMESTAMP_LTZ:
+		case C.SQLT_TIMESTAMP_TZ:
 			defines[i].dataType = C.SQLT_TIMESTAMP_TZ
 			defines[i].maxSize = C.sb4(sizeOfNilPointer)
-			var timestampP *unsafe.Pointer
-			timestampP, _, err = stmt.conn.ociDescriptorAlloc(C.OCI_DTYPE_TIMESTAMP_TZ, 0)
+			defines[i].pbuf, err = stmt.conn.ociDescriptorArrayAlloc(C.OCI_DTYPE_TIMESTAMP_TZ, defines[i].dataType, arraySize)
+			if err != nil {
+				freeDefines(defines)
+				return nil, err
+			}
+
+		case C.SQLT_TIMESTAMP_LTZ:
+			defines[i].dataType = C.SQLT_TIMESTAMP_LTZ
+			defines[i].maxSize = C.sb4(sizeOfNilPointer)
+			defines[i].pbuf, err = stmt.conn.ociDescriptorArrayAlloc(C.OCI_DTYPE_TIMESTAMP_LTZ, defines[i].dataType, arraySize)
 			if err != nil {
 				freeDefines(defines)
 				return nil, err
 			}
-			defines[i].pbuf = unsafe.Pointer(timestampP)
 
 		case C.SQLT_INTERVAL_DS:
 			defines[i].dataType = C.SQLT_INTERVAL_DS
 			defines[i].maxSize = C.sb4(sizeOfNilPointer)
-			var intervalP *unsafe.Pointer
-			intervalP, _, err = stmt.conn.ociDescriptorAlloc(C.OCI_DTYPE_INTERVAL_DS, 0)
+			defines[i].pbuf, err = stmt.conn.ociDescriptorArrayAlloc(C.OCI_DTYPE_INTERVAL_DS, defines[i].dataType, arraySize)
 			if err != nil {
 				freeDefines(defines)
 				return nil, err
 			}
-			defines[i].pbuf = unsafe.Pointer(intervalP)
 
 		case C.SQLT_INTERVAL_YM:
 			defines[i].dataType = C.SQLT_INTERVAL_YM
 			defines[i].maxSize = C.sb4(sizeOfNilPointer)
-			var intervalP *unsafe.Pointer
-			intervalP, _, err = stmt.conn.ociDescriptorAlloc(C.OCI_DTYPE_INTERVAL_YM, 0)
+			defines[i].pbuf, err = stmt.conn.ociDescriptorArrayAlloc(C.OCI_DTYPE_INTERVAL_YM, defines[i].dataType, arraySize)
 			if err != nil {
 				freeDefines(defines)
 				return nil, err
 			}
-			defines[i].pbuf = unsafe.Pointer(intervalP)
 
 		case C.SQLT_RDD: // rowid
 			defines[i].dataType = C.SQLT_AFC
 			defines[i].maxSize = 40
-			defines[i].pbuf = C.malloc(C.size_t(defines[i].maxSize))
+			defines[i].pbuf = C.malloc(C.size_t(defines[i].maxSize) * C.size_t(arraySize))
 
 		case C.SQLT_RSET: // ref cursor
 			defines[i].dataType = dataType
@@ -637,12 +1796,20 @@ func (stmt *Stmt) makeDefines() ([]defineStruct, error) {
 				freeDefines(defines)
 				return nil, err
 			}
-			defines[i].pbuf = unsafe.Pointer(stmtP)
+			defines[i].pbuf = C.malloc(C.size_t(sizeOfNilPointer))
+			*(*unsafe.Pointer)(defines[i].pbuf) = *stmtP
+
+		case C.SQLT_NTY: // object or collection
+			err = stmt.objectDefine(&defines[i], param)
+			if err != nil {
+				freeDefines(defines)
+				return nil, err
+			}
 
 		default:
 			defines[i].dataType = C.SQLT_AFC
 			defines[i].maxSize = C.sb4(maxSize)
-			defines[i].pbuf = C.malloc(C.size_t(defines[i].maxSize))
+			defines[i].pbuf = C.malloc(C.size_t(defines[i].maxSize) * C.size_t(arraySize))
 		}
 
 		result := C.OCIDefineByPos(
@@ -662,11 +1829,77 @@ func (stmt *Stmt) makeDefines() ([]defineStruct, error) {
 			freeDefines(defines)
 			return nil, stmt.conn.getError(result)
 		}
+
+		if defines[i].dataType == C.SQLT_AFC && defines[i].charsetForm == C.SQLCS_NCHAR {
+			// NCHAR and NVARCHAR2 columns are fetched in the national character set
+			err = stmt.conn.ociAttrSet(unsafe.Pointer(defines[i].defineHandle), C.OCI_HTYPE_DEFINE,
+				unsafe.Pointer(&defines[i].charsetForm), 0, C.OCI_ATTR_CHARSET_FORM)
+			if err != nil {
+				freeDefines(defines)
+				return nil, err
+			}
+		}
+
+		if defines[i].dataType == C.SQLT_CLOB || defines[i].dataType == C.SQLT_BLOB {
+			err = stmt.setLobPrefetch(defines[i].defineHandle)
+			if err != nil {
+				freeDefines(defines)
+				return nil, err
+			}
+		}
+
+		if defines[i].dataType == C.SQLT_NTY {
+			err = stmt.ociDefineObject(&defines[i])
+			if err != nil {
+				freeDefines(defines)
+				return nil, err
+			}
+		}
 	}
 
 	return defines, nil
 }
 
+// setLobPrefetch sets the size of the LOB data fetched with the LOB locators of a define handle
+func (stmt *Stmt) setLobPrefetch(defineHandle *C.OCIDefine) error {
+	size := stmt.conn.lobPrefetchSize
+	if stmt.lobPrefetch > 0 {
+		size = C.ub4(stmt.lobPrefetch)
+	}
+	if size < 1 {
+		return nil
+	}
+
+	err := stmt.conn.ociAttrSet(unsafe.Pointer(defineHandle), C.OCI_HTYPE_DEFINE, unsafe.Pointer(&size), 0, C.OCI_ATTR_LOBPREFETCH_SIZE)
+	if err != nil {
+		return err
+	}
+	prefetchLength := C.boolean(C.TRUE)
+	return stmt.conn.ociAttrSet(unsafe.Pointer(defineHandle), C.OCI_HTYPE_DEFINE, unsafe.Pointer(&prefetchLength), 0, C.OCI_ATTR_LOBPREFETCH_LENGTH)
+}
+
+// hasColumnDataType returns true if any column in the select-list is the data type
+func (stmt *Stmt) hasColumnDataType(paramCount int, dataType C.ub2) (bool, error) {
+	for i := 0; i < paramCount; i++ {
+		param, err := stmt.ociParamGet(C.ub4(i + 1))
+		if err != nil {
+			return false, err
+		}
+
+		var paramDataType C.ub2
+		_, err = stmt.conn.ociAttrGet(param, unsafe.Pointer(&paramDataType), C.OCI_ATTR_DATA_TYPE)
+		C.OCIDescriptorFree(unsafe.Pointer(param), C.OCI_DTYPE_PARAM)
+		if err != nil {
+			return false, err
+		}
+		if paramDataType == dataType {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 // getRowid returns the rowid
 func (stmt *Stmt) getRowid() (string, error) {
 	rowidP, _, err := stmt.conn.ociDescriptorAlloc(C.OCI_DTYPE_ROWID, 0)
@@ -706,32 +1939,54 @@ func (stmt *Stmt) rowsAffected() (int64, error) {
 // Exec runs an exec query
 func (stmt *Stmt) Exec(values []driver.Value) (driver.Result, error) {
 	stmt.ctx = context.Background()
-	binds, err := stmt.bindValues(values, nil)
+	options := stmt.takeOptions()
+	binds, err := stmt.bindValues(values, nil, &options)
 	if err != nil {
 		return nil, err
 	}
 
-	return stmt.exec(binds)
+	return stmt.exec(binds, options)
 }
 
 // ExecContext run a exec query with context
 func (stmt *Stmt) ExecContext(ctx context.Context, namedValues []driver.NamedValue) (driver.Result, error) {
 	stmt.ctx = ctx
-	binds, err := stmt.bindValues(nil, namedValues)
+	options := stmt.takeOptions()
+	binds, err := stmt.bindValues(nil, namedValues, &options)
 	if err != nil {
 		return nil, err
 	}
 
-	return stmt.exec(binds)
+	return stmt.exec(binds, options)
 }
 
-func (stmt *Stmt) exec(binds []bindStruct) (driver.Result, error) {
+func (stmt *Stmt) exec(binds []bindStruct, options stmtOptions) (driver.Result, error) {
 	defer freeBinds(binds)
 
+	iters, err := bindsIters(binds)
+	if err != nil {
+		return nil, err
+	}
+	if iters == 0 {
+		// empty array binds, nothing to execute
+		return &Result{stmt: stmt, rowidErr: ErrNoRowid}, nil
+	}
+
 	mode := C.ub4(C.OCI_DEFAULT)
 	if stmt.conn.inTransaction == false {
 		mode = mode | C.OCI_COMMIT_ON_SUCCESS
 	}
+	if options.batchErrors {
+		mode = mode | C.OCI_BATCH_ERRORS
+	}
+	if options.rowCounts != nil {
+		mode = mode | C.OCI_RETURN_ROW_COUNT_ARRAY
+	}
+
+	err = stmt.writeLobReaders(binds)
+	if err != nil {
+		return nil, err
+	}
 
 	if stmt.ctx.Err() != nil {
 		return nil, stmt.ctx.Err()
@@ -739,12 +1994,21 @@ func (stmt *Stmt) exec(binds []bindStruct) (driver.Result, error) {
 
 	done := make(chan struct{})
 	go stmt.conn.ociBreakDone(stmt.ctx, done)
-	err := stmt.ociStmtExecute(1, mode)
+	err = stmt.ociStmtExecute(iters, mode)
 	close(done)
 	if err != nil && err != ErrOCISuccessWithInfo {
 		return nil, err
 	}
 
+	var rowErrors []RowError
+	if options.batchErrors {
+		// the row errors are in the error handle, so they are read before any other OCI call can replace them
+		rowErrors, err = stmt.getBatchErrors()
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	result := Result{stmt: stmt}
 
 	result.rowsAffected, result.rowsAffectedErr = stmt.rowsAffected()
@@ -754,35 +2018,162 @@ func (stmt *Stmt) exec(binds []bindStruct) (driver.Result, error) {
 		result.rowid, result.rowidErr = stmt.getRowid()
 	}
 
+	if options.rowCounts != nil {
+		*options.rowCounts, err = stmt.rowCounts()
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err = stmt.outputBoundParameters(binds)
 	if err != nil {
 		return nil, err
 	}
 
+	if len(rowErrors) > 0 {
+		return nil, &BatchError{RowsAffected: result.rowsAffected, Errors: rowErrors}
+	}
+
 	return &result, nil
 }
 
+// rowCounts returns the number of rows affected by each iteration of an exec with OCI_RETURN_ROW_COUNT_ARRAY
+func (stmt *Stmt) rowCounts() ([]int64, error) {
+	var rowCountsP *C.ub8 // array of row counts, owned by the statement handle
+	size, err := stmt.ociAttrGet(unsafe.Pointer(&rowCountsP), C.OCI_ATTR_DML_ROW_COUNT_ARRAY)
+	if err != nil {
+		return nil, err
+	}
+
+	count := int(size) / C.sizeof_ub8
+	rowCounts := make([]int64, count)
+	if count > 0 {
+		ub8s := (*[1 << 27]C.ub8)(unsafe.Pointer(rowCountsP))[:count:count]
+		for i := 0; i < count; i++ {
+			rowCounts[i] = int64(ub8s[i])
+		}
+	}
+
+	return rowCounts, nil
+}
+
+// getBatchErrors returns the row errors of an exec with OCI_BATCH_ERRORS
+func (stmt *Stmt) getBatchErrors() ([]RowError, error) {
+	var numErrors C.ub4
+	_, err := stmt.ociAttrGet(unsafe.Pointer(&numErrors), C.OCI_ATTR_NUM_DML_ERRORS)
+	if err != nil {
+		return nil, err
+	}
+	if numErrors == 0 {
+		return nil, nil
+	}
+
+	rowErrors := make([]RowError, numErrors)
+	for i := C.ub4(0); i < numErrors; i++ {
+		var handle *unsafe.Pointer
+		handle, _, err = stmt.conn.ociHandleAlloc(C.OCI_HTYPE_ERROR, 0)
+		if err != nil {
+			return nil, fmt.Errorf("allocate error handle error: %v", err)
+		}
+
+		// get the error handle for the row error
+		result := C.OCIParamGet(
+			unsafe.Pointer(stmt.conn.errHandle), // error handle holding the batch errors
+			C.OCI_HTYPE_ERROR,                   // handle type
+			stmt.conn.errHandle,                 // error handle
+			handle,                              // the error handle for the row error
+			i,                                   // the row error number, starts from 0
+		)
+		if result != C.OCI_SUCCESS {
+			C.OCIHandleFree(*handle, C.OCI_HTYPE_ERROR)
+			return nil, stmt.conn.getError(result)
+		}
+
+		var rowOffset C.ub4
+		result = C.OCIAttrGet(
+			*handle,                    // the error handle for the row error
+			C.OCI_HTYPE_ERROR,          // handle type
+			unsafe.Pointer(&rowOffset), // the row offset in the bound arrays
+			nil,                        // size of the attribute value
+			C.OCI_ATTR_DML_ROW_OFFSET,  // attribute type
+			stmt.conn.errHandle,        // error handle
+		)
+		if result != C.OCI_SUCCESS {
+			C.OCIHandleFree(*handle, C.OCI_HTYPE_ERROR)
+			return nil, stmt.conn.getError(result)
+		}
+
+		rowErrors[i].Offset = int(rowOffset)
+		rowErrors[i].Code, rowErrors[i].Err = ociErrorGet((*C.OCIError)(*handle))
+		C.OCIHandleFree(*handle, C.OCI_HTYPE_ERROR)
+	}
+
+	return rowErrors, nil
+}
+
 // outputBoundParameters sets bound parameters
+// If a string or []byte value was truncated, the other binds are still set then the first ErrTruncated is returned.
 func (stmt *Stmt) outputBoundParameters(binds []bindStruct) error {
 	var err error
+	var truncated error
 
 	for i, bind := range binds {
+		if bind.returning != nil {
+			err = stmt.outputReturningBind(&bind)
+			if err != nil {
+				return fmt.Errorf("returning for column %v - error: %v", i, err)
+			}
+			continue
+		}
+		if bind.objectType != nil {
+			if bind.out.Dest != nil {
+				err = stmt.outputObjectBind(&bind)
+				if err != nil {
+					return fmt.Errorf("object for column %v - error: %v", i, err)
+				}
+			}
+			continue
+		}
+		if bind.curArrayLen != nil {
+			if bind.out.Dest != nil {
+				err = stmt.outputArrayBind(&bind)
+				if err != nil {
+					return fmt.Errorf("PL/SQL array for column %v - error: %v", i, err)
+				}
+			}
+			continue
+		}
 		if bind.pbuf != nil {
-			switch dest := bind.out.Dest.(type) {
+			outDest := bind.out.Dest
+			if dest, ok := outDest.(*NString); ok {
+				// NString out binds are strings bound in the national character set
+				outDest = (*string)(dest)
+			}
+			switch dest := outDest.(type) {
+
+			case *driver.Rows:
+				stmtP := (*unsafe.Pointer)(bind.pbuf)
+				var rows *Rows
+				rows, err = stmt.cursorRows((*C.OCIStmt)(*stmtP))
+				if err != nil {
+					return fmt.Errorf("ref cursor for column %v - error: %v", i, err)
+				}
+				// the rows now own the statement handle
+				*stmtP = nil
+				*dest = rows
 
 			case *string:
 				switch {
-				case *bind.indicator > 0: // indicator variable is the actual length before truncation
-					spaces := int(*bind.indicator) - int(*bind.length)
-					if spaces < 0 {
-						return fmt.Errorf("spaces less than 0 for column %v", i)
+				case *bind.indicator > 0, *bind.indicator == -2: // truncated, indicator is the actual length or -2 if larger than sb2
+					*dest = C.GoStringN((*C.char)(bind.pbuf), C.int(*bind.length))
+					if truncated == nil {
+						truncated = bind.errTruncated()
 					}
-					*dest = C.GoStringN((*C.char)(bind.pbuf), C.int(*bind.length)) + strings.Repeat(" ", spaces)
 				case *bind.indicator == 0: // Normal
 					if bind.dataType == C.SQLT_CLOB {
 						lobLocator := (**C.OCILobLocator)(bind.pbuf)
 						var buffer []byte
-						buffer, err = stmt.conn.ociLobRead(*lobLocator, C.SQLCS_IMPLICIT)
+						buffer, err = stmt.conn.ociLobRead(*lobLocator, bind.charsetForm())
 						if err != nil {
 							return err
 						}
@@ -792,31 +2183,23 @@ func (stmt *Stmt) outputBoundParameters(binds []bindStruct) error {
 					}
 				case *bind.indicator == -1: // The selected value is null
 					*dest = "" // best attempt at Go nil string
-				case *bind.indicator == -2: // Item is greater than the length of the output variable; the item has been truncated.
-					*dest = C.GoStringN((*C.char)(bind.pbuf), C.int(*bind.length))
-					// TODO: should this be an error?
 				default:
 					return fmt.Errorf("unknown column indicator %d for column %v", *bind.indicator, i)
 				}
 			case *sql.NullString:
 				switch {
-				case *bind.indicator > 0: // indicator variable is the actual length before truncation
-					spaces := int(*bind.indicator) - int(*bind.length)
-					if spaces < 0 {
-						return fmt.Errorf("spaces less than 0 for column %v", i)
-					}
-					dest.String = C.GoStringN((*C.char)(bind.pbuf), C.int(*bind.length)) + strings.Repeat(" ", spaces)
+				case *bind.indicator > 0, *bind.indicator == -2: // truncated, indicator is the actual length or -2 if larger than sb2
+					dest.String = C.GoStringN((*C.char)(bind.pbuf), C.int(*bind.length))
 					dest.Valid = true
+					if truncated == nil {
+						truncated = bind.errTruncated()
+					}
 				case *bind.indicator == 0: // Normal
 					dest.String = C.GoStringN((*C.char)(bind.pbuf), C.int(*bind.length))
 					dest.Valid = true
 				case *bind.indicator == -1: // The selected value is null
 					dest.String = ""
 					dest.Valid = false
-				case *bind.indicator == -2: // Item is greater than the length of the output variable; the item has been truncated.
-					dest.String = C.GoStringN((*C.char)(bind.pbuf), C.int(*bind.length))
-					dest.Valid = true
-					// TODO: should this be an error?
 				default:
 					return fmt.Errorf("unknown column indicator %d for column %v", *bind.indicator, i)
 				}
@@ -840,18 +2223,25 @@ func (stmt *Stmt) outputBoundParameters(binds []bindStruct) error {
 					dest.Valid = true
 				}
 
-			case *uint:
-				*dest = uint(getUint64(bind.pbuf))
-			case *uint64:
-				*dest = getUint64(bind.pbuf)
+			case *uint, *uint64, *uintptr, *big.Int:
+				if *bind.indicator == -1 {
+					err = setUnsignedDest(dest, "0")
+				} else {
+					var number Number
+					number, err = bind.numberValue()
+					if err == nil {
+						err = setUnsignedDest(dest, string(number))
+					}
+				}
+				if err != nil {
+					return fmt.Errorf("number for column %v - error: %v", i, err)
+				}
 			case *uint32:
 				*dest = uint32(getUint64(bind.pbuf))
 			case *uint16:
 				*dest = uint16(getUint64(bind.pbuf))
 			case *uint8:
 				*dest = uint8(getUint64(bind.pbuf))
-			case *uintptr:
-				*dest = uintptr(getUint64(bind.pbuf))
 
 			case *float64:
 				buf := (*[8]byte)(bind.pbuf)[0:8]
@@ -887,29 +2277,27 @@ func (stmt *Stmt) outputBoundParameters(binds []bindStruct) error {
 				}
 
 			case *bool:
-				buf := (*[1 << 30]byte)(bind.pbuf)[0:1]
-				*dest = buf[0] != 0
+				*dest = bind.boolValue()
 			case *sql.NullBool:
 				if *bind.indicator == -1 {
 					dest.Bool = false
 					dest.Valid = false
 				} else {
-					buf := (*[1 << 30]byte)(bind.pbuf)[0:1]
-					dest.Bool = buf[0] != 0
+					dest.Bool = bind.boolValue()
 					dest.Valid = true
 				}
 
 			case *[]byte:
 				switch {
-				case *bind.indicator > 0: // indicator variable is the actual length before truncation
-					if int(*bind.indicator)-int(*bind.length) < 0 {
-						return fmt.Errorf("spaces less than 0 for column %v", i)
+				case *bind.indicator > 0, *bind.indicator == -2: // truncated, indicator is the actual length or -2 if larger than sb2
+					*dest = C.GoBytes(bind.pbuf, C.int(*bind.length))
+					if truncated == nil {
+						truncated = bind.errTruncated()
 					}
-					*dest = C.GoBytes(bind.pbuf, C.int(*bind.indicator))
 				case *bind.indicator == 0: // Normal
 					if bind.dataType == C.SQLT_BLOB {
 						lobLocator := (**C.OCILobLocator)(bind.pbuf)
-						*dest, err = stmt.conn.ociLobRead(*lobLocator, C.SQLCS_IMPLICIT)
+						*dest, err = stmt.conn.ociLobRead(*lobLocator, bind.charsetForm())
 						if err != nil {
 							return err
 						}
@@ -918,17 +2306,301 @@ func (stmt *Stmt) outputBoundParameters(binds []bindStruct) error {
 					}
 				case *bind.indicator == -1: // The selected value is null
 					*dest = nil
-				case *bind.indicator == -2: // Item is greater than the length of the output variable; the item has been truncated.
-					*dest = C.GoBytes(bind.pbuf, C.int(*bind.length))
-					// TODO: should this be an error?
 				default:
 					return fmt.Errorf("unknown column indicator %d for column %v", *bind.indicator, i)
 				}
 
+			case *Number:
+				if *bind.indicator == -1 {
+					*dest = ""
+				} else {
+					*dest, err = bind.numberValue()
+					if err != nil {
+						return fmt.Errorf("number for column %v - error: %v", i, err)
+					}
+				}
+
+			case *Clob:
+				if *bind.indicator == -1 {
+					*dest = ""
+				} else {
+					lobLocator := (**C.OCILobLocator)(bind.pbuf)
+					var buffer []byte
+					buffer, err = stmt.conn.ociLobRead(*lobLocator, bind.charsetForm())
+					if err != nil {
+						return fmt.Errorf("CLOB for column %v - error: %v", i, err)
+					}
+					*dest = Clob(buffer)
+				}
+			case *Blob:
+				if *bind.indicator == -1 {
+					*dest = nil
+				} else {
+					lobLocator := (**C.OCILobLocator)(bind.pbuf)
+					*dest, err = stmt.conn.ociLobRead(*lobLocator, bind.charsetForm())
+					if err != nil {
+						return fmt.Errorf("BLOB for column %v - error: %v", i, err)
+					}
+				}
+
+			case *Lob:
+				// a locator bound by makeLobValueBind is moved to the Lob so it is not freed with the bind
+				if bind.lob == nil && *bind.indicator != -1 {
+					dest.conn = stmt.conn
+					dest.locator = *(**C.OCILobLocator)(bind.pbuf)
+					dest.offset = 0
+					dest.reader = nil
+					*(*unsafe.Pointer)(bind.pbuf) = nil
+				}
+
+			case *time.Time:
+				if *bind.indicator == -1 {
+					*dest = time.Time{}
+				} else {
+					*dest, err = bind.timeValue(stmt.conn)
+					if err != nil {
+						return fmt.Errorf("time for column %v - error: %v", i, err)
+					}
+				}
+			case *sql.NullTime:
+				if *bind.indicator == -1 {
+					dest.Time = time.Time{}
+					dest.Valid = false
+				} else {
+					dest.Time, err = bind.timeValue(stmt.conn)
+					if err != nil {
+						return fmt.Errorf("time for column %v - error: %v", i, err)
+					}
+					dest.Valid = true
+				}
+			case *Date, *Timestamp, *TimestampTZ:
+				var aTime time.Time
+				if *bind.indicator != -1 {
+					aTime, err = bind.timeValue(stmt.conn)
+					if err != nil {
+						return fmt.Errorf("time for column %v - error: %v", i, err)
+					}
+				}
+				switch dest := dest.(type) {
+				case *Date:
+					*dest = Date(aTime)
+				case *Timestamp:
+					*dest = Timestamp(aTime)
+				case *TimestampTZ:
+					*dest = TimestampTZ(aTime)
+				}
+
+			case *time.Duration:
+				if *bind.indicator == -1 {
+					*dest = 0
+				} else {
+					*dest, err = stmt.conn.ociIntervalToDuration(*(**C.OCIInterval)(bind.pbuf))
+					if err != nil {
+						return fmt.Errorf("ociIntervalToDuration for column %v - error: %v", i, err)
+					}
+				}
+			case *IntervalDS:
+				if *bind.indicator == -1 {
+					*dest = 0
+				} else {
+					var duration time.Duration
+					duration, err = stmt.conn.ociIntervalToDuration(*(**C.OCIInterval)(bind.pbuf))
+					if err != nil {
+						return fmt.Errorf("ociIntervalToDuration for column %v - error: %v", i, err)
+					}
+					*dest = IntervalDS(duration)
+				}
+			case *IntervalYM:
+				if *bind.indicator == -1 {
+					*dest = 0
+				} else {
+					var months int64
+					months, err = stmt.conn.ociIntervalToMonths(*(**C.OCIInterval)(bind.pbuf))
+					if err != nil {
+						return fmt.Errorf("ociIntervalToMonths for column %v - error: %v", i, err)
+					}
+					*dest = IntervalYM(months)
+				}
+
 			}
 		}
 	}
 
+	return truncated
+}
+
+// numberValue returns the value of a SQLT_VNU bind
+func (bind *bindStruct) numberValue() (Number, error) {
+	buf := (*[22]byte)(bind.pbuf)
+	if buf[0] > 21 {
+		return "", errors.New("invalid VARNUM length")
+	}
+	return decodeNumber(buf[1 : 1+buf[0]])
+}
+
+// setUnsignedDest sets a *uint, *uint64, *uintptr, or *big.Int destination to the integer number
+func setUnsignedDest(dest interface{}, number string) error {
+	if bigInt, ok := dest.(*big.Int); ok {
+		value, err := Number(number).BigInt()
+		if err != nil {
+			return err
+		}
+		bigInt.Set(value)
+		return nil
+	}
+
+	value, err := strconv.ParseUint(number, 10, 64)
+	if err != nil {
+		return err
+	}
+	switch dest := dest.(type) {
+	case *uint:
+		if uint64(uint(value)) != value {
+			return fmt.Errorf("number %v overflows uint", number)
+		}
+		*dest = uint(value)
+	case *uint64:
+		*dest = value
+	case *uintptr:
+		if uint64(uintptr(value)) != value {
+			return fmt.Errorf("number %v overflows uintptr", number)
+		}
+		*dest = uintptr(value)
+	}
+	return nil
+}
+
+// boolValue returns the value of a bool bind, a SQLT_BOL or a 0/1 SQLT_INT
+func (bind *bindStruct) boolValue() bool {
+	if bind.dataType == C.SQLT_BOL {
+		return *(*C.int)(bind.pbuf) != 0
+	}
+	return *(*byte)(bind.pbuf) != 0
+}
+
+// timeValue returns the value of a time bind, a SQLT_ODT, SQLT_TIMESTAMP, or SQLT_TIMESTAMP_TZ
+func (bind *bindStruct) timeValue(conn *Conn) (time.Time, error) {
+	if bind.dataType == C.SQLT_ODT {
+		return conn.ociDateToTime((*C.OCIDate)(bind.pbuf)), nil
+	}
+	aTime, err := conn.ociDateTimeToTime(*(**C.OCIDateTime)(bind.pbuf), bind.dataType != C.SQLT_TIMESTAMP)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return *aTime, nil
+}
+
+// errTruncated returns an ErrTruncated for the bind with the actual length from the indicator
+func (bind *bindStruct) errTruncated() error {
+	actualLen := -1
+	if *bind.indicator > 0 {
+		actualLen = int(*bind.indicator)
+	}
+	return &ErrTruncated{Param: bind.param, ActualLen: actualLen}
+}
+
+// outputArrayBind sets the out slice to the elements returned in the PL/SQL array bind.
+// The slice is resized to the returned number of elements.
+func (stmt *Stmt) outputArrayBind(bind *bindStruct) error {
+	destValue := reflect.ValueOf(bind.out.Dest).Elem()
+	size := int(*bind.curArrayLen)
+	if size > bind.arrayLen {
+		return fmt.Errorf("returned %v elements is greater than max elements %v", size, bind.arrayLen)
+	}
+
+	lengths := (*[1 << 27]C.ub2)(unsafe.Pointer(bind.length))[:size:size]
+	indicators := (*[1 << 27]C.sb2)(unsafe.Pointer(bind.indicator))[:size:size]
+	slice := destValue.Slice3(0, size, destValue.Cap())
+
+	for i := 0; i < size; i++ {
+		var value interface{}
+		if indicators[i] != -1 {
+			pbuf := unsafe.Pointer(uintptr(bind.pbuf) + uintptr(i)*uintptr(bind.maxSize))
+			var err error
+			value, err = stmt.conn.arrayElementToGo(bind.dataType, bind.maxSize, pbuf, int(lengths[i]))
+			if err != nil {
+				return fmt.Errorf("element %v - error: %v", i, err)
+			}
+		}
+
+		err := setArrayElement(slice.Index(i), value)
+		if err != nil {
+			return fmt.Errorf("element %v - error: %v", i, err)
+		}
+	}
+
+	destValue.Set(slice)
+
+	return nil
+}
+
+// arrayElementToGo converts an array bind element in the buffer to a Go value
+func (conn *Conn) arrayElementToGo(dataType C.ub2, maxSize C.sb4, pbuf unsafe.Pointer, length int) (interface{}, error) {
+	switch dataType {
+	case C.SQLT_INT:
+		if maxSize == 1 {
+			return *(*byte)(pbuf) != 0, nil
+		}
+		return getInt64(pbuf), nil
+	case C.SQLT_BOL:
+		return *(*C.int)(pbuf) != 0, nil
+	case C.SQLT_BDOUBLE:
+		return *(*float64)(pbuf), nil
+	case C.SQLT_AFC, C.SQLT_CHR:
+		return C.GoStringN((*C.char)(pbuf), C.int(length)), nil
+	case C.SQLT_BIN:
+		return C.GoBytes(pbuf, C.int(length)), nil
+	case C.SQLT_VNU:
+		buf := (*[22]byte)(pbuf)
+		if buf[0] > 21 {
+			return nil, errors.New("invalid VARNUM length")
+		}
+		return decodeNumber(buf[1 : 1+buf[0]])
+	case C.SQLT_ODT:
+		return conn.ociDateToTime((*C.OCIDate)(pbuf)), nil
+	case C.SQLT_TIMESTAMP, C.SQLT_TIMESTAMP_TZ:
+		aTime, err := conn.ociDateTimeToTime(*(**C.OCIDateTime)(pbuf), dataType == C.SQLT_TIMESTAMP_TZ)
+		if err != nil {
+			return nil, fmt.Errorf("ociDateTimeToTime error: %v", err)
+		}
+		return *aTime, nil
+	}
+	return nil, fmt.Errorf("unsupported data type %v", dataType)
+}
+
+// setArrayElement sets the slice element to the value.
+// Elements that are sql.Scanner are scanned, pointer elements are allocated, and nil values set the element to its zero value.
+func setArrayElement(elem reflect.Value, value interface{}) error {
+	if scanner, ok := elem.Addr().Interface().(sql.Scanner); ok {
+		return scanner.Scan(value)
+	}
+	if value == nil {
+		elem.Set(reflect.Zero(elem.Type()))
+		return nil
+	}
+	if elem.Kind() == reflect.Ptr {
+		pointer := reflect.New(elem.Type().Elem())
+		err := setArrayElement(pointer.Elem(), value)
+		if err != nil {
+			return err
+		}
+		elem.Set(pointer)
+		return nil
+	}
+
+	if number, ok := value.(Number); ok {
+		switch dest := elem.Addr().Interface().(type) {
+		case *uint, *uint64, *uintptr, *big.Int:
+			return setUnsignedDest(dest, string(number))
+		}
+	}
+
+	valueOf := reflect.ValueOf(value)
+	if !valueOf.Type().ConvertibleTo(elem.Type()) || (elem.Kind() == reflect.String && valueOf.Kind() != reflect.String) {
+		return fmt.Errorf("cannot convert %T to %v", value, elem.Type())
+	}
+	elem.Set(valueOf.Convert(elem.Type()))
+
 	return nil
 }
 
@@ -985,9 +2657,9 @@ func (stmt *Stmt) ociBindByName(name []byte, bind *bindStruct) error {
 		unsafe.Pointer(bind.indicator), // Pointer to an indicator variable or array
 		bind.length,                    // lengths are in bytes in general
 		nil,                            // Pointer to the array of column-level return codes
-		0,                              // A maximum array length parameter
-		nil,                            // Current array length parameter
-		C.OCI_DEFAULT,                  // The mode. Recommended to set to OCI_DEFAULT, which makes the bind variable have the same encoding as its statement.
+		bind.maxArrayLen,               // A maximum array length parameter, only used for PL/SQL arrays
+		bind.curArrayLen,               // Current array length parameter, only used for PL/SQL arrays
+		bind.mode(),                    // The mode. OCI_DEFAULT, or OCI_DATA_AT_EXEC for DML RETURNING binds
 	)
 
 	return stmt.conn.getError(result)
@@ -1006,9 +2678,9 @@ func (stmt *Stmt) ociBindByPos(position C.ub4, bind *bindStruct) error {
 		unsafe.Pointer(bind.indicator), // Pointer to an indicator variable or array
 		bind.length,                    // lengths are in bytes in general
 		nil,                            // Pointer to the array of column-level return codes
-		0,                              // A maximum array length parameter
-		nil,                            // Current array length parameter
-		C.OCI_DEFAULT,                  // The mode. Recommended to set to OCI_DEFAULT, which makes the bind variable have the same encoding as its statement.
+		bind.maxArrayLen,               // A maximum array length parameter, only used for PL/SQL arrays
+		bind.curArrayLen,               // Current array length parameter, only used for PL/SQL arrays
+		bind.mode(),                    // The mode. OCI_DEFAULT, or OCI_DATA_AT_EXEC for DML RETURNING binds
 	)
 
 	return stmt.conn.getError(result)